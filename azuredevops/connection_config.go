@@ -8,6 +8,7 @@ import (
 type azureDevOpsConfig struct {
 	OrganizationURL     *string `cty:"org_service_url"`
 	PersonalAccessToken *string `cty:"personal_access_token"`
+	MaxBuildLogSize     *int    `cty:"max_build_log_size"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -17,6 +18,9 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"personal_access_token": {
 		Type: schema.TypeString,
 	},
+	"max_build_log_size": {
+		Type: schema.TypeInt,
+	},
 }
 
 func ConfigInstance() interface{} {
@@ -21,11 +21,42 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		DefaultTransform: transform.FromCamel(),
 
 		TableMap: map[string]*plugin.Table{
-			"azuredevops_build":            tableAzureDevOpsBuild(ctx),
-			"azuredevops_build_definition": tableAzureDevOpsBuildDefinition(ctx),
-			"azuredevops_git_repository":   tableAzureDevOpsGetRepository(ctx),
-			"azuredevops_pipeline":         tableAzureDevOpsPipeline(ctx),
-			"azuredevops_project":          tableAzureDevOpsProject(ctx),
+			"azuredevops_access_control_entry":      tableAzureDevOpsAccessControlEntry(ctx),
+			"azuredevops_agent":                     tableAzureDevOpsAgent(ctx),
+			"azuredevops_agent_job_request":         tableAzureDevOpsAgentJobRequest(ctx),
+			"azuredevops_agent_pool":                tableAzureDevOpsAgentPool(ctx),
+			"azuredevops_agent_queue":               tableAzureDevOpsAgentQueue(ctx),
+			"azuredevops_audit_log":                 tableAzureDevOpsAuditLog(ctx),
+			"azuredevops_build":                     tableAzureDevOpsBuild(ctx),
+			"azuredevops_build_artifact":            tableAzureDevOpsBuildArtifact(ctx),
+			"azuredevops_build_change":              tableAzureDevOpsBuildChange(ctx),
+			"azuredevops_build_definition":          tableAzureDevOpsBuildDefinition(ctx),
+			"azuredevops_build_definition_variable": tableAzureDevOpsBuildDefinitionVariable(ctx),
+			"azuredevops_build_log":                 tableAzureDevOpsBuildLog(ctx),
+			"azuredevops_build_work_item":           tableAzureDevOpsBuildWorkItem(ctx),
+			"azuredevops_deployment_group":          tableAzureDevOpsDeploymentGroup(ctx),
+			"azuredevops_deployment_target":         tableAzureDevOpsDeploymentTarget(ctx),
+			"azuredevops_environment":               tableAzureDevOpsEnvironment(ctx),
+			"azuredevops_environment_deployment":    tableAzureDevOpsEnvironmentDeployment(ctx),
+			"azuredevops_git_repository":            tableAzureDevOpsGetRepository(ctx),
+			"azuredevops_git_repository_permission": tableAzureDevOpsGitRepositoryPermission(ctx),
+			"azuredevops_group":                     tableAzureDevOpsGroup(ctx),
+			"azuredevops_group_membership":          tableAzureDevOpsGroupMembership(ctx),
+			"azuredevops_personal_access_token":     tableAzureDevOpsPersonalAccessToken(ctx),
+			"azuredevops_pipeline":                  tableAzureDevOpsPipeline(ctx),
+			"azuredevops_pipeline_check":            tableAzureDevOpsPipelineCheck(ctx),
+			"azuredevops_pipeline_permission":       tableAzureDevOpsPipelinePermission(ctx),
+			"azuredevops_project":                   tableAzureDevOpsProject(ctx),
+			"azuredevops_release":                   tableAzureDevOpsRelease(ctx),
+			"azuredevops_release_definition":        tableAzureDevOpsReleaseDefinition(ctx),
+			"azuredevops_release_deployment":        tableAzureDevOpsReleaseDeployment(ctx),
+			"azuredevops_secure_file":               tableAzureDevOpsSecureFile(ctx),
+			"azuredevops_security_namespace":        tableAzureDevOpsSecurityNamespace(ctx),
+			"azuredevops_service_endpoint":          tableAzureDevOpsServiceEndpoint(ctx),
+			"azuredevops_service_hook_notification": tableAzureDevOpsServiceHookNotification(ctx),
+			"azuredevops_service_hook_subscription": tableAzureDevOpsServiceHookSubscription(ctx),
+			"azuredevops_user":                      tableAzureDevOpsUser(ctx),
+			"azuredevops_variable_group":            tableAzureDevOpsVariableGroup(ctx),
 		},
 	}
 
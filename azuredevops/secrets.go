@@ -0,0 +1,270 @@
+package azuredevops
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// secretNamePattern matches variable names that usually hold credentials.
+var secretNamePattern = regexp.MustCompile(`(?i)(passw(or)?d|pwd|secret|token|api[_\-.]?key|access[_\-.]?key|private[_\-.]?key|connection[_\-.]?string|credential|(^|[_\-.])key$)`)
+
+// variableReferencePattern matches values that only reference other variables,
+// e.g. "$(MySecret)", which never contain a credential themselves.
+var variableReferencePattern = regexp.MustCompile(`^\$[({][^)}]+[)}]$`)
+
+const (
+	// minSecretEntropyLength is the shortest value that is checked for entropy,
+	// shorter values produce too many false positives.
+	minSecretEntropyLength = 20
+
+	// minSecretEntropy is the Shannon entropy, in bits per character, above
+	// which a value is considered to be random enough to be a credential.
+	minSecretEntropy = 4.0
+
+	// minWeakSecretEntropy is a lower entropy threshold which on its own is not
+	// enough to flag a value, but still adds to its score.
+	minWeakSecretEntropy = 3.5
+
+	// minNamedSecretLength and minNamedSecretEntropy are the much lower bars a
+	// value has to clear when the variable name already looks like a credential,
+	// so that settings such as PasswordMinLength=8 are not flagged.
+	minNamedSecretLength  = 8
+	minNamedSecretEntropy = 2.5
+)
+
+// knownTokenPatterns match the well known prefixes or formats of credentials
+// issued by common services.
+var knownTokenPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^(ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{30,}`),
+	regexp.MustCompile(`^github_pat_[A-Za-z0-9_]{30,}`),
+	regexp.MustCompile(`^glpat-[A-Za-z0-9_\-]{20,}`),
+	regexp.MustCompile(`^xox[abposr]-[A-Za-z0-9\-]{10,}`),
+	regexp.MustCompile(`^(AKIA|ASIA)[A-Z0-9]{16}$`),
+	regexp.MustCompile(`^AIza[A-Za-z0-9_\-]{35}$`),
+	regexp.MustCompile(`^(sk|rk)_(live|test)_[A-Za-z0-9]{20,}`),
+	regexp.MustCompile(`^SG\.[A-Za-z0-9_\-]{16,}\.[A-Za-z0-9_\-]{16,}`),
+	regexp.MustCompile(`^npm_[A-Za-z0-9]{36}$`),
+	regexp.MustCompile(`^eyJ[A-Za-z0-9_\-]+\.eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`),
+	regexp.MustCompile(`-----BEGIN ([A-Z]+ )?PRIVATE KEY-----`),
+	regexp.MustCompile(`(?i)(AccountKey|SharedAccessKey|SharedAccessSignature)=[^;]+`),
+}
+
+// isPlaintextSecretCandidate returns true if a non-secret variable looks like
+// it holds a credential, either because its value matches a known token format
+// or is a long, high-entropy string, or because its name looks like a
+// credential and its value is credential-like.
+func isPlaintextSecretCandidate(name string, value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" || variableReferencePattern.MatchString(value) {
+		return false
+	}
+
+	if hasKnownTokenPattern(value) {
+		return true
+	}
+
+	if secretNamePattern.MatchString(name) && isCredentialLikeValue(value) {
+		return true
+	}
+
+	return isHighEntropyString(value)
+}
+
+// plaintextSecretScore scores how likely it is that a non-secret variable
+// holds a credential, from 0 (unlikely) to 100 (almost certainly). Values
+// matching a known token format always score 100, otherwise the score is
+// built up from the variable name, if the value is credential-like, and the
+// entropy of the value.
+func plaintextSecretScore(name string, value string) int {
+	value = strings.TrimSpace(value)
+	if value == "" || variableReferencePattern.MatchString(value) {
+		return 0
+	}
+
+	if hasKnownTokenPattern(value) {
+		return 100
+	}
+
+	score := 0
+	if secretNamePattern.MatchString(name) && isCredentialLikeValue(value) {
+		score += 50
+	}
+
+	if isHighEntropyString(value) {
+		score += 50
+	} else if len(value) >= minSecretEntropyLength && !strings.ContainsAny(value, " \t\r\n") &&
+		shannonEntropy(value) >= minWeakSecretEntropy {
+		score += 25
+	}
+
+	return score
+}
+
+// hasKnownTokenPattern returns true if the value matches the format of a
+// credential issued by a common service.
+func hasKnownTokenPattern(value string) bool {
+	for _, pattern := range knownTokenPatterns {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// booleanValues are the values commonly used for boolean settings.
+var booleanValues = map[string]bool{
+	"true": true, "false": true,
+	"yes": true, "no": true,
+	"on": true, "off": true,
+	"enabled": true, "disabled": true,
+}
+
+// isCredentialLikeValue returns true if the value could be a credential, i.e.
+// it is long enough, is not a boolean or a number, and has some entropy.
+func isCredentialLikeValue(value string) bool {
+	if len(value) < minNamedSecretLength {
+		return false
+	}
+
+	if booleanValues[strings.ToLower(value)] {
+		return false
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return false
+	}
+
+	return shannonEntropy(value) >= minNamedSecretEntropy
+}
+
+// isHighEntropyString returns true if the value is a single long token with a
+// high Shannon entropy.
+func isHighEntropyString(value string) bool {
+	if len(value) < minSecretEntropyLength || strings.ContainsAny(value, " \t\r\n") {
+		return false
+	}
+
+	return shannonEntropy(value) >= minSecretEntropy
+}
+
+// shannonEntropy returns the Shannon entropy of the value in bits per character.
+func shannonEntropy(value string) float64 {
+	if value == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range value {
+		counts[r]++
+		total++
+	}
+
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
+
+// secretInputNamePattern matches the names of service hook inputs which hold
+// credentials, e.g. basicAuthPassword, accountKey or httpHeaders.
+var secretInputNamePattern = regexp.MustCompile(`(?i)(passw(or)?d|secret|token|key$|httpheaders|authorization|connectionstring|sas)`)
+
+// maskedSecretValue replaces the values of inputs which look like credentials.
+const maskedSecretValue = "********"
+
+// webhookHostPatterns match the hosts of incoming webhooks whose path is
+// itself the credential, e.g. Slack and Microsoft Teams webhooks.
+var webhookHostPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)^hooks\.slack\.com$`),
+	regexp.MustCompile(`(?i)(^|\.)webhook\.office\.com$`),
+}
+
+// maskSecretInputs returns a copy of the inputs where the values of inputs
+// which look like credentials, by name or by value, are masked. The
+// credentials embedded in URL values are masked too.
+func maskSecretInputs(inputs *map[string]string) map[string]string {
+	if inputs == nil {
+		return nil
+	}
+
+	masked := make(map[string]string, len(*inputs))
+	for name, value := range *inputs {
+		if value != "" && (secretInputNamePattern.MatchString(name) || hasKnownTokenPattern(value)) {
+			value = maskedSecretValue
+		} else {
+			value = maskSecretURL(value)
+		}
+		masked[name] = value
+	}
+
+	return masked
+}
+
+// maskSecretURL masks the parts of a URL which may hold a credential: the
+// user info, the values of the query string and the path of known webhook
+// hosts. Values which are not absolute HTTP URLs are returned unchanged.
+func maskSecretURL(value string) string {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || u.Host == "" || (!strings.EqualFold(u.Scheme, "http") && !strings.EqualFold(u.Scheme, "https")) {
+		return value
+	}
+
+	if u.User == nil && u.RawQuery == "" && !isWebhookHost(u.Hostname()) {
+		return value
+	}
+
+	var b strings.Builder
+	b.WriteString(u.Scheme + "://")
+	if u.User != nil {
+		b.WriteString(maskedSecretValue + "@")
+	}
+	b.WriteString(u.Host)
+
+	// Only the first segment of a webhook path is kept, e.g. /services for
+	// Slack or /webhookb2 for Teams, the rest identifies the webhook.
+	path := u.EscapedPath()
+	if isWebhookHost(u.Hostname()) {
+		segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+		if len(segments) == 2 && segments[1] != "" {
+			path = "/" + segments[0] + "/" + maskedSecretValue
+		}
+	}
+	b.WriteString(path)
+
+	if u.RawQuery != "" {
+		params := strings.Split(u.RawQuery, "&")
+		for i, param := range params {
+			if key, _, found := strings.Cut(param, "="); found {
+				params[i] = key + "=" + maskedSecretValue
+			} else {
+				params[i] = maskedSecretValue
+			}
+		}
+		b.WriteString("?" + strings.Join(params, "&"))
+	}
+
+	if u.Fragment != "" {
+		b.WriteString("#" + u.EscapedFragment())
+	}
+
+	return b.String()
+}
+
+// isWebhookHost returns true if the host is a known incoming webhook host.
+func isWebhookHost(host string) bool {
+	for _, pattern := range webhookHostPatterns {
+		if pattern.MatchString(host) {
+			return true
+		}
+	}
+
+	return false
+}
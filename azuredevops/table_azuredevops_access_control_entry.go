@@ -0,0 +1,395 @@
+package azuredevops
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// identityBatchSize is the number of identity descriptors resolved per request.
+const identityBatchSize = 50
+
+// accessControlEntry is an ACE along with the ACL it belongs to, the actions
+// of its namespace and the identity it applies to.
+type accessControlEntry struct {
+	security.AccessControlEntry
+	NamespaceID        uuid.UUID
+	Token              *string
+	InheritPermissions *bool
+	Identity           *identity.Identity
+	actions            []security.ActionDefinition
+}
+
+func tableAzureDevOpsAccessControlEntry(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_access_control_entry",
+		Description: "Represents an access control entry (ACE) which allows or denies permissions in a security namespace to an identity.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listAccessControlEntries,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "namespace_id", Require: plugin.Required},
+				{Name: "descriptor", Require: plugin.Optional},
+				{Name: "token", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "allow",
+				Description: "The bitmask of the permissions explicitly allowed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Allow"),
+			},
+			{
+				Name:        "allow_permissions",
+				Description: "The names of the permissions explicitly allowed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(accessControlEntryPermissions, "allow"),
+			},
+			{
+				Name:        "deny",
+				Description: "The bitmask of the permissions explicitly denied.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Deny"),
+			},
+			{
+				Name:        "deny_permissions",
+				Description: "The names of the permissions explicitly denied.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(accessControlEntryPermissions, "deny"),
+			},
+			{
+				Name:        "descriptor",
+				Description: "The identity descriptor the entry applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Descriptor"),
+			},
+			{
+				Name:        "effective_allow",
+				Description: "The bitmask of the permissions allowed, including inherited permissions.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ExtendedInfo.EffectiveAllow"),
+			},
+			{
+				Name:        "effective_allow_permissions",
+				Description: "The names of the permissions allowed, including inherited permissions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(accessControlEntryPermissions, "effective_allow"),
+			},
+			{
+				Name:        "effective_deny",
+				Description: "The bitmask of the permissions denied, including inherited permissions.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ExtendedInfo.EffectiveDeny"),
+			},
+			{
+				Name:        "effective_deny_permissions",
+				Description: "The names of the permissions denied, including inherited permissions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromP(accessControlEntryPermissions, "effective_deny"),
+			},
+			{
+				Name:        "identity_display_name",
+				Description: "The display name of the identity the entry applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(accessControlEntryIdentityDisplayName),
+			},
+			{
+				Name:        "identity_id",
+				Description: "The ID of the identity the entry applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity.Id"),
+			},
+			{
+				Name:        "identity_is_container",
+				Description: "Whether the identity the entry applies to is a group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Identity.IsContainer"),
+			},
+			{
+				Name:        "identity_subject_descriptor",
+				Description: "The subject descriptor of the identity the entry applies to, as used by the Graph APIs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity.SubjectDescriptor"),
+			},
+			{
+				Name:        "inherit_permissions",
+				Description: "Whether the ACL inherits permissions from its parent token.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InheritPermissions"),
+			},
+			{
+				Name:        "inherited_allow",
+				Description: "The bitmask of the permissions allowed through inheritance.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ExtendedInfo.InheritedAllow"),
+			},
+			{
+				Name:        "inherited_deny",
+				Description: "The bitmask of the permissions denied through inheritance.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ExtendedInfo.InheritedDeny"),
+			},
+			{
+				Name:        "namespace_id",
+				Description: "The ID of the security namespace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamespaceID"),
+			},
+			{
+				Name:        "token",
+				Description: "The security token of the ACL the entry belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Token"),
+			},
+		},
+	}
+}
+
+func listAccessControlEntries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	namespaceID, err := uuid.Parse(d.KeyColumnQuals["namespace_id"].GetStringValue())
+	if err != nil {
+		logger.Error("listAccessControlEntries", "parse_namespace_id_error", err)
+		return nil, err
+	}
+
+	input := security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceID,
+	}
+
+	if value, ok := d.KeyColumnQuals["descriptor"]; ok {
+		descriptor := value.GetStringValue()
+		if descriptor != "" {
+			input.Descriptors = &descriptor
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["token"]; ok {
+		token := value.GetStringValue()
+		if token != "" {
+			input.Token = &token
+		}
+	}
+
+	// Resolving identities takes extra requests, so only do it when they are selected.
+	resolveIdentities := isColumnRequested(d, "identity_display_name") ||
+		isColumnRequested(d, "identity_id") ||
+		isColumnRequested(d, "identity_is_container") ||
+		isColumnRequested(d, "identity_subject_descriptor")
+
+	entries, err := queryAccessControlEntries(ctx, d, input, resolveIdentities)
+	if err != nil {
+		logger.Error("listAccessControlEntries", "query_access_control_entries_error", err)
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		d.StreamListItem(ctx, entry)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// queryAccessControlEntries returns the ACEs of the ACLs matching the input,
+// along with the actions of the namespace used to decode their bitmasks. If
+// resolveIdentities is set the identities the ACEs apply to are also resolved.
+func queryAccessControlEntries(ctx context.Context, d *plugin.QueryData, input security.QueryAccessControlListsArgs, resolveIdentities bool) ([]accessControlEntry, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_access_control_entry.queryAccessControlEntries", "connection_error", err)
+		return nil, err
+	}
+
+	client := security.NewClient(ctx, connection)
+
+	namespaces, err := client.QuerySecurityNamespaces(ctx, security.QuerySecurityNamespacesArgs{
+		SecurityNamespaceId: input.SecurityNamespaceId,
+	})
+	if err != nil {
+		logger.Error("queryAccessControlEntries", "query_security_namespaces_error", err)
+		return nil, err
+	}
+
+	var actions []security.ActionDefinition
+	for _, namespace := range *namespaces {
+		if namespace.Actions != nil {
+			actions = append(actions, *namespace.Actions...)
+		}
+	}
+
+	includeExtendedInfo := true
+	input.IncludeExtendedInfo = &includeExtendedInfo
+
+	acls, err := client.QueryAccessControlLists(ctx, input)
+	if err != nil {
+		logger.Error("queryAccessControlEntries", "query_access_control_lists_error", err)
+		return nil, err
+	}
+
+	var entries []accessControlEntry
+	var descriptors []string
+	for _, acl := range *acls {
+		if acl.AcesDictionary == nil {
+			continue
+		}
+
+		for _, ace := range *acl.AcesDictionary {
+			entries = append(entries, accessControlEntry{
+				AccessControlEntry: ace,
+				NamespaceID:        *input.SecurityNamespaceId,
+				Token:              acl.Token,
+				InheritPermissions: acl.InheritPermissions,
+				actions:            actions,
+			})
+
+			if ace.Descriptor != nil {
+				descriptors = append(descriptors, *ace.Descriptor)
+			}
+		}
+	}
+
+	if !resolveIdentities {
+		return entries, nil
+	}
+
+	identities, err := readIdentities(ctx, d, descriptors)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		if entries[i].Descriptor != nil {
+			entries[i].Identity = identities[strings.ToLower(*entries[i].Descriptor)]
+		}
+	}
+
+	return entries, nil
+}
+
+// readIdentities resolves identity descriptors to identities, keyed by the
+// lower case descriptor. Descriptors which cannot be resolved are omitted.
+func readIdentities(ctx context.Context, d *plugin.QueryData, descriptors []string) (map[string]*identity.Identity, error) {
+	logger := plugin.Logger(ctx)
+	identities := make(map[string]*identity.Identity)
+
+	var unique []string
+	seen := make(map[string]bool)
+	for _, descriptor := range descriptors {
+		key := strings.ToLower(descriptor)
+		if !seen[key] {
+			seen[key] = true
+			unique = append(unique, descriptor)
+		}
+	}
+
+	if len(unique) == 0 {
+		return identities, nil
+	}
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_access_control_entry.readIdentities", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := identity.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_access_control_entry.readIdentities", "client_error", err)
+		return nil, err
+	}
+
+	for start := 0; start < len(unique); start += identityBatchSize {
+		end := start + identityBatchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+
+		batch := strings.Join(unique[start:end], ",")
+		response, err := client.ReadIdentities(ctx, identity.ReadIdentitiesArgs{Descriptors: &batch})
+		if err != nil {
+			logger.Error("readIdentities", "read_identities_error", err)
+			return nil, err
+		}
+
+		for i := range *response {
+			resolved := (*response)[i]
+			if resolved.Descriptor != nil {
+				identities[strings.ToLower(*resolved.Descriptor)] = &resolved
+			}
+		}
+	}
+
+	return identities, nil
+}
+
+// securityPermissionNames returns the names of the actions whose bits are set in the mask.
+func securityPermissionNames(actions []security.ActionDefinition, mask *int) []string {
+	names := []string{}
+	if mask == nil {
+		return names
+	}
+
+	for _, action := range actions {
+		if action.Bit != nil && action.Name != nil && *mask&*action.Bit != 0 {
+			names = append(names, *action.Name)
+		}
+	}
+
+	return names
+}
+
+func accessControlEntryPermissions(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	entry := d.HydrateItem.(accessControlEntry)
+
+	var mask *int
+	switch d.Param.(string) {
+	case "allow":
+		mask = entry.Allow
+	case "deny":
+		mask = entry.Deny
+	case "effective_allow":
+		if entry.ExtendedInfo != nil {
+			mask = entry.ExtendedInfo.EffectiveAllow
+		}
+	case "effective_deny":
+		if entry.ExtendedInfo != nil {
+			mask = entry.ExtendedInfo.EffectiveDeny
+		}
+	}
+
+	return securityPermissionNames(entry.actions, mask), nil
+}
+
+func accessControlEntryIdentityDisplayName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	entry := d.HydrateItem.(accessControlEntry)
+	return identityDisplayName(entry.Identity), nil
+}
+
+// identityDisplayName returns the custom display name of the identity if it
+// has one, otherwise the display name from its provider.
+func identityDisplayName(ident *identity.Identity) interface{} {
+	if ident == nil {
+		return nil
+	}
+
+	if ident.CustomDisplayName != nil && *ident.CustomDisplayName != "" {
+		return *ident.CustomDisplayName
+	}
+
+	return ident.ProviderDisplayName
+}
@@ -0,0 +1,228 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsAgent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_agent",
+		Description: "Represents an agent registered in an Azure DevOps agent pool.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listAgents,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "pool_id", Require: plugin.Required},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "links",
+				Description: "The links to related resources.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Links"),
+			},
+			{
+				Name:        "access_point",
+				Description: "This agent's access point.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessPoint"),
+			},
+			{
+				Name:        "agent_version_capability",
+				Description: "The value of the Agent.Version system capability.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(agentVersionCapability),
+			},
+			{
+				Name:        "assigned_agent_cloud_request",
+				Description: "The agent cloud request that's currently associated with this agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssignedAgentCloudRequest"),
+			},
+			{
+				Name:        "assigned_request",
+				Description: "The request which is currently assigned to this agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AssignedRequest"),
+			},
+			{
+				Name:        "authorization",
+				Description: "Authorization information for this agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Authorization"),
+			},
+			{
+				Name:        "created_on",
+				Description: "Date on which this agent was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Whether or not this agent should run jobs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Enabled"),
+			},
+			{
+				Name:        "id",
+				Description: "Identifier of the agent.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "last_completed_request",
+				Description: "The last request which was completed by this agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LastCompletedRequest"),
+			},
+			{
+				Name:        "max_parallelism",
+				Description: "Maximum job parallelism allowed for this agent.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MaxParallelism"),
+			},
+			{
+				Name:        "name",
+				Description: "Name of the agent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "os_description",
+				Description: "Agent OS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OsDescription"),
+			},
+			{
+				Name:        "pending_update",
+				Description: "Pending update for this agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PendingUpdate"),
+			},
+			{
+				Name:        "pool_id",
+				Description: "ID of the agent pool the agent belongs to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("pool_id"),
+			},
+			{
+				Name:        "properties",
+				Description: "The properties of the agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "Provisioning state of this agent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProvisioningState"),
+			},
+			{
+				Name:        "status",
+				Description: "Whether or not the agent is online.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "status_changed_on",
+				Description: "Date on which the last connectivity status change occurred.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StatusChangedOn.Time"),
+			},
+			{
+				Name:        "system_capabilities",
+				Description: "The system capabilities of the agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SystemCapabilities"),
+			},
+			{
+				Name:        "user_capabilities",
+				Description: "The user-defined capabilities of the agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UserCapabilities"),
+			},
+			{
+				Name:        "version",
+				Description: "Agent version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Version"),
+			},
+		},
+	}
+}
+
+func listAgents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	poolID := int(d.KeyColumnQuals["pool_id"].GetInt64Value())
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_agent.listAgents", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_agent.listAgents", "client_error", err)
+		return nil, err
+	}
+
+	// Capabilities and requests make the response much larger, so only include them when selected.
+	includeCapabilities := isColumnRequested(d, "agent_version_capability") ||
+		isColumnRequested(d, "system_capabilities") ||
+		isColumnRequested(d, "user_capabilities")
+	includeAssignedRequest := isColumnRequested(d, "assigned_request")
+	includeLastCompletedRequest := isColumnRequested(d, "last_completed_request")
+
+	input := taskagent.GetAgentsArgs{
+		PoolId:                      &poolID,
+		IncludeCapabilities:         &includeCapabilities,
+		IncludeAssignedRequest:      &includeAssignedRequest,
+		IncludeLastCompletedRequest: &includeLastCompletedRequest,
+	}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.AgentName = &name
+		}
+	}
+
+	response, err := client.GetAgents(ctx, input)
+	if err != nil {
+		logger.Error("listAgents", "list_agents_error", err)
+		return nil, err
+	}
+
+	for _, agent := range *response {
+		d.StreamListItem(ctx, agent)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func agentVersionCapability(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	agent := d.HydrateItem.(taskagent.TaskAgent)
+	if agent.SystemCapabilities == nil {
+		return nil, nil
+	}
+
+	version, ok := (*agent.SystemCapabilities)["Agent.Version"]
+	if !ok {
+		return nil, nil
+	}
+
+	return version, nil
+}
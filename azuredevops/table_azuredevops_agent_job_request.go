@@ -0,0 +1,321 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	ado "github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsAgentJobRequest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_agent_job_request",
+		Description: "Represents a job request queued against an Azure DevOps agent pool.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listAgentJobRequests,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "pool_id", Require: plugin.Required},
+				{Name: "agent_id", Require: plugin.Optional},
+				{Name: "completed_request_count", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "agent_delays",
+				Description: "The sources of delays in assigning an agent to the request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AgentDelays"),
+			},
+			{
+				Name:        "agent_id",
+				Description: "The ID of the agent allocated for this request.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ReservedAgent.Id"),
+			},
+			{
+				Name:        "agent_name",
+				Description: "The name of the agent allocated for this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReservedAgent.Name"),
+			},
+			{
+				Name:        "agent_wait_seconds",
+				Description: "The number of seconds between the request being assigned and an agent receiving it. For requests not yet received this is the time waited so far.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(agentJobRequestAgentWaitSeconds),
+			},
+			{
+				Name:        "assign_time",
+				Description: "The date/time this request was assigned.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AssignTime.Time"),
+			},
+			{
+				Name:        "completed_request_count",
+				Description: "The number of completed requests to return per agent.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("completed_request_count"),
+			},
+			{
+				Name:        "data",
+				Description: "Additional data about the request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data"),
+			},
+			{
+				Name:        "definition_id",
+				Description: "The ID of the pipeline definition associated with this request.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Definition.Id"),
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the pipeline definition associated with this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Definition.Name"),
+			},
+			{
+				Name:        "demands",
+				Description: "A list of demands required to fulfill this request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Demands"),
+			},
+			{
+				Name:        "duration_seconds",
+				Description: "The number of seconds between an agent receiving the request and it finishing. For running requests this is the time elapsed so far.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(agentJobRequestDurationSeconds),
+			},
+			{
+				Name:        "finish_time",
+				Description: "The date/time this request was finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FinishTime.Time"),
+			},
+			{
+				Name:        "host_id",
+				Description: "The host which triggered this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("HostId"),
+			},
+			{
+				Name:        "job_id",
+				Description: "ID of the job resulting from this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobId"),
+			},
+			{
+				Name:        "job_name",
+				Description: "Name of the job resulting from this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobName"),
+			},
+			{
+				Name:        "locked_until",
+				Description: "The deadline for the agent to renew the lock.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LockedUntil.Time"),
+			},
+			{
+				Name:        "matches_all_agents_in_pool",
+				Description: "Indicates whether the request can run on any agent in the pool.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MatchesAllAgentsInPool"),
+			},
+			{
+				Name:        "orchestration_id",
+				Description: "The orchestration ID of the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OrchestrationId"),
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the pipeline run associated with this request.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Owner.Id"),
+			},
+			{
+				Name:        "owner_name",
+				Description: "The name of the pipeline run associated with this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner.Name"),
+			},
+			{
+				Name:        "plan_id",
+				Description: "Internal ID for the orchestration plan connected with this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlanId"),
+			},
+			{
+				Name:        "plan_type",
+				Description: "Internal detail representing the type of orchestration plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlanType"),
+			},
+			{
+				Name:        "pool_id",
+				Description: "The ID of the pool this request targets.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("pool_id"),
+			},
+			{
+				Name:        "queue_id",
+				Description: "The ID of the queue this request targets.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("QueueId"),
+			},
+			{
+				Name:        "queue_time",
+				Description: "The date/time this request was queued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueueTime.Time"),
+			},
+			{
+				Name:        "queue_wait_seconds",
+				Description: "The number of seconds between the request being queued and assigned to an agent. For requests not yet assigned this is the time waited so far.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(agentJobRequestQueueWaitSeconds),
+			},
+			{
+				Name:        "receive_time",
+				Description: "The date/time this request was received by an agent.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ReceiveTime.Time"),
+			},
+			{
+				Name:        "request_id",
+				Description: "ID of the request.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RequestId"),
+			},
+			{
+				Name:        "result",
+				Description: "The result of this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result"),
+			},
+			{
+				Name:        "scope_id",
+				Description: "Scope of the pipeline; matches the project ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScopeId"),
+			},
+			{
+				Name:        "service_owner",
+				Description: "The service which owns this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceOwner"),
+			},
+			{
+				Name:        "status_message",
+				Description: "The status message of the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StatusMessage"),
+			},
+		},
+	}
+}
+
+func listAgentJobRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	poolID := d.KeyColumnQuals["pool_id"].GetInt64Value()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_agent_job_request.listAgentJobRequests", "connection_error", err)
+		return nil, err
+	}
+
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	top := 999
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	routeValues := make(map[string]string)
+	routeValues["poolId"] = strconv.FormatInt(poolID, 10)
+
+	queryParams := url.Values{}
+	queryParams.Set("$top", strconv.Itoa(top))
+
+	if value, ok := d.KeyColumnQuals["agent_id"]; ok {
+		queryParams.Set("agentId", strconv.FormatInt(value.GetInt64Value(), 10))
+	}
+
+	if value, ok := d.KeyColumnQuals["completed_request_count"]; ok {
+		queryParams.Set("completedRequestCount", strconv.FormatInt(value.GetInt64Value(), 10))
+	}
+
+	locationId, _ := uuid.Parse("fc825784-c92a-4299-9221-998a02d1b54f")
+
+	for {
+		response, err := client.Send(ctx, http.MethodGet, locationId, "6.0-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			logger.Error("listAgentJobRequests", "list_agent_job_requests_error", err)
+			return nil, err
+		}
+
+		var requests []taskagent.TaskAgentJobRequest
+		err = client.UnmarshalCollectionBody(response, &requests)
+		if err != nil {
+			logger.Error("listAgentJobRequests", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, request := range requests {
+			d.StreamListItem(ctx, request)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		continuationToken := response.Header.Get(ado.HeaderKeyContinuationToken)
+		if continuationToken == "" {
+			break
+		}
+
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return nil, nil
+}
+
+func agentJobRequestQueueWaitSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	request := d.HydrateItem.(taskagent.TaskAgentJobRequest)
+
+	// A finished request that was never assigned (e.g. cancelled while queued) has no wait time to report.
+	if request.AssignTime == nil && request.FinishTime != nil {
+		return nil, nil
+	}
+
+	return secondsBetween(request.QueueTime, request.AssignTime), nil
+}
+
+func agentJobRequestAgentWaitSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	request := d.HydrateItem.(taskagent.TaskAgentJobRequest)
+
+	if request.ReceiveTime == nil && request.FinishTime != nil {
+		return nil, nil
+	}
+
+	return secondsBetween(request.AssignTime, request.ReceiveTime), nil
+}
+
+func agentJobRequestDurationSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	request := d.HydrateItem.(taskagent.TaskAgentJobRequest)
+	return secondsBetween(request.ReceiveTime, request.FinishTime), nil
+}
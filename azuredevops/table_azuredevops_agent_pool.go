@@ -0,0 +1,178 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsAgentPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_agent_pool",
+		Description: "Represents an Azure DevOps agent pool.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listAgentPools,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "name", Require: plugin.Optional},
+				{Name: "pool_type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "agent_cloud_id",
+				Description: "The ID of the associated agent cloud.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AgentCloudId"),
+			},
+			{
+				Name:        "auto_provision",
+				Description: "Whether or not a queue should be automatically provisioned for each project collection.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AutoProvision"),
+			},
+			{
+				Name:        "auto_size",
+				Description: "Whether or not the pool should autosize itself based on the Agent Cloud Provider settings.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AutoSize"),
+			},
+			{
+				Name:        "auto_update",
+				Description: "Whether or not agents in this pool are allowed to automatically update.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AutoUpdate"),
+			},
+			{
+				Name:        "created_by",
+				Description: "Creator of the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The date/time of the pool creation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "is_hosted",
+				Description: "Indicates whether or not this pool is managed by the service.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsHosted"),
+			},
+			{
+				Name:        "is_legacy",
+				Description: "Indicates whether the pool is legacy.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsLegacy"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "options",
+				Description: "Additional pool settings and details.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Options"),
+			},
+			{
+				Name:        "owner",
+				Description: "Owner or administrator of the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Owner"),
+			},
+			{
+				Name:        "pool_type",
+				Description: "The type of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolType"),
+			},
+			{
+				Name:        "properties",
+				Description: "The properties of the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope"),
+			},
+			{
+				Name:        "size",
+				Description: "The current size of the pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Size"),
+			},
+			{
+				Name:        "target_size",
+				Description: "Target parallelism.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TargetSize"),
+			},
+		},
+	}
+}
+
+func listAgentPools(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_agent_pool.listAgentPools", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_agent_pool.listAgentPools", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetAgentPoolsArgs{}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.PoolName = &name
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["pool_type"]; ok {
+		poolType := taskagent.TaskAgentPoolType(value.GetStringValue())
+		if poolType != "" {
+			input.PoolType = &poolType
+		}
+	}
+
+	response, err := client.GetAgentPools(ctx, input)
+	if err != nil {
+		logger.Error("listAgentPools", "list_agent_pools_error", err)
+		return nil, err
+	}
+
+	for _, pool := range *response {
+		d.StreamListItem(ctx, pool)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,114 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsAgentQueue(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_agent_queue",
+		Description: "Represents an Azure DevOps agent queue, which links a project to an agent pool.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listAgentQueues,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "id",
+				Description: "ID of the queue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "is_hosted",
+				Description: "Indicates whether or not the pool is managed by the service.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Pool.IsHosted"),
+			},
+			{
+				Name:        "name",
+				Description: "Name of the queue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "pool",
+				Description: "Pool reference for this queue.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Pool"),
+			},
+			{
+				Name:        "pool_id",
+				Description: "ID of the pool used by this queue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Pool.Id"),
+			},
+			{
+				Name:        "pool_name",
+				Description: "Name of the pool used by this queue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Pool.Name"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this queue belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+		},
+	}
+}
+
+func listAgentQueues(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_agent_queue.listAgentQueues", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_agent_queue.listAgentQueues", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetAgentQueuesArgs{
+		Project: &projectID,
+	}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.QueueName = &name
+		}
+	}
+
+	response, err := client.GetAgentQueues(ctx, input)
+	if err != nil {
+		logger.Error("listAgentQueues", "list_agent_queues_error", err)
+		return nil, err
+	}
+
+	for _, queue := range *response {
+		d.StreamListItem(ctx, queue)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
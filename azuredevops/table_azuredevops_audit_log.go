@@ -0,0 +1,215 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/audit"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsAuditLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_audit_log",
+		Description: "Represents an entry in the Azure DevOps organization audit log.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listAuditLogEntries,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "timestamp", Operators: []string{">", ">=", "<", "<=", "="}, Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "action_id",
+				Description: "The action ID of the event, e.g. Git.RepositoryCreated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionId"),
+			},
+			{
+				Name:        "activity_id",
+				Description: "The ID of the activity (request) which caused the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActivityId"),
+			},
+			{
+				Name:        "actor_cuid",
+				Description: "The consistently unique ID of the identity which performed the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActorCUID"),
+			},
+			{
+				Name:        "actor_display_name",
+				Description: "The display name of the identity which performed the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActorDisplayName"),
+			},
+			{
+				Name:        "actor_upn",
+				Description: "The user principal name of the identity which performed the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActorUPN"),
+			},
+			{
+				Name:        "actor_user_id",
+				Description: "The ID of the user who performed the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActorUserId"),
+			},
+			{
+				Name:        "area",
+				Description: "The area of Azure DevOps the event occurred in, e.g. Git or Policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Area"),
+			},
+			{
+				Name:        "authentication_mechanism",
+				Description: "The type of authentication used by the actor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuthenticationMechanism"),
+			},
+			{
+				Name:        "category",
+				Description: "The category of the action, e.g. create, modify, remove, execute or access.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Category"),
+			},
+			{
+				Name:        "category_display_name",
+				Description: "The display name of the category of the action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CategoryDisplayName"),
+			},
+			{
+				Name:        "correlation_id",
+				Description: "The ID which links related events together.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CorrelationId"),
+			},
+			{
+				Name:        "data",
+				Description: "The external data of the event, such as the names of the objects which were changed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data"),
+			},
+			{
+				Name:        "details",
+				Description: "The human readable description of the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the audit log entry.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IP address the action was performed from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IpAddress"),
+			},
+			{
+				Name:        "project_id",
+				Description: "The ID of the project the event occurred in, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectId"),
+			},
+			{
+				Name:        "project_name",
+				Description: "The name of the project the event occurred in, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectName"),
+			},
+			{
+				Name:        "scope_display_name",
+				Description: "The display name of the scope the event occurred in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScopeDisplayName"),
+			},
+			{
+				Name:        "scope_id",
+				Description: "The ID of the scope (organization or project) the event occurred in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScopeId"),
+			},
+			{
+				Name:        "scope_type",
+				Description: "The type of the scope the event occurred in, e.g. organization or project.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScopeType"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The date and time the event occurred.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp.Time"),
+			},
+			{
+				Name:        "user_agent",
+				Description: "The user agent of the actor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserAgent"),
+			},
+		},
+	}
+}
+
+func listAuditLogEntries(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_audit_log.listAuditLogEntries", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := audit.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_audit_log.listAuditLogEntries", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	input := audit.QueryLogArgs{
+		BatchSize: &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	input.StartTime, input.EndTime = getQualTimeRange(d, "timestamp")
+
+	for {
+		response, err := client.QueryLog(ctx, input)
+		if err != nil {
+			logger.Error("listAuditLogEntries", "query_log_error", err)
+			return nil, err
+		}
+
+		if response.DecoratedAuditLogEntries != nil {
+			for _, entry := range *response.DecoratedAuditLogEntries {
+				d.StreamListItem(ctx, entry)
+
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if response.HasMore == nil || !*response.HasMore || response.ContinuationToken == nil || *response.ContinuationToken == "" {
+			break
+		}
+
+		input.ContinuationToken = response.ContinuationToken
+	}
+
+	return nil, nil
+}
@@ -18,6 +18,11 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 			Hydrate: listBuilds,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "project_id", Require: plugin.Required},
+				{Name: "definition_id", Require: plugin.Optional},
+				{Name: "queue_id", Require: plugin.Optional},
+				{Name: "repository_id", Require: plugin.Optional},
+				{Name: "repository_type", Require: plugin.Optional},
+				{Name: "requested_for_unique_name", Require: plugin.Optional},
 			},
 		},
 
@@ -27,6 +32,12 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:      proto.ColumnType_JSON,
 				Transform: transform.FromField("Links"),
 			},
+			{
+				Name:        "agent_pool_name",
+				Description: "The name of the agent pool used by the queue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Queue.Pool.Name"),
+			},
 			{
 				Name:        "agent_specification_id",
 				Description: "Agent specification unique identifier.",
@@ -57,6 +68,18 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Definition"),
 			},
+			{
+				Name:        "definition_id",
+				Description: "The ID of the definition associated with the build.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Definition.Id"),
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the definition associated with the build.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Definition.Name"),
+			},
 			{
 				Name:        "deleted",
 				Description: "Indicates whether the build has been deleted.",
@@ -87,6 +110,12 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Demands"),
 			},
+			{
+				Name:        "duration_seconds",
+				Description: "The number of seconds between the start and finish of the build. For running builds this is the time elapsed so far.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(buildDurationSeconds),
+			},
 			{
 				Name:        "finish_time",
 				Description: "The time that the build was completed.",
@@ -99,6 +128,12 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("Id"),
 			},
+			{
+				Name:        "is_running",
+				Description: "Indicates whether the build has started but not yet finished.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(buildIsRunning),
+			},
 			{
 				Name:        "keep_forever",
 				Description: "Indicates whether the build should be skipped by retention policies.",
@@ -111,6 +146,18 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("LastChangedBy"),
 			},
+			{
+				Name:        "last_changed_by_display_name",
+				Description: "The display name of the process or person that last changed the build.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastChangedBy.DisplayName"),
+			},
+			{
+				Name:        "last_changed_by_unique_name",
+				Description: "The unique name of the process or person that last changed the build.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LastChangedBy.UniqueName"),
+			},
 			{
 				Name:        "last_changed_date",
 				Description: "The date the build was last changed.",
@@ -171,6 +218,18 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Queue"),
 			},
+			{
+				Name:        "queue_id",
+				Description: "The ID of the queue.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Queue.Id"),
+			},
+			{
+				Name:        "queue_name",
+				Description: "The name of the queue.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Queue.Name"),
+			},
 			{
 				Name:        "queue_options",
 				Description: "Additional options for queueing the build.",
@@ -189,6 +248,12 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("QueueTime.Time"),
 			},
+			{
+				Name:        "queue_wait_seconds",
+				Description: "The number of seconds the build waited in the queue before starting. For builds that have not started this is the time waited so far.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(buildQueueWaitSeconds),
+			},
 			{
 				Name:        "reason",
 				Description: "The reason that the build was created.",
@@ -201,18 +266,54 @@ func tableAzureDevOpsBuild(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Repository"),
 			},
+			{
+				Name:        "repository_id",
+				Description: "The ID of the repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Id"),
+			},
+			{
+				Name:        "repository_type",
+				Description: "The type of the repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Repository.Type"),
+			},
 			{
 				Name:        "requested_by",
 				Description: "The identity that queued the build.",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("RequestedBy"),
 			},
+			{
+				Name:        "requested_by_display_name",
+				Description: "The display name of the identity that queued the build.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedBy.DisplayName"),
+			},
+			{
+				Name:        "requested_by_unique_name",
+				Description: "The unique name of the identity that queued the build.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedBy.UniqueName"),
+			},
 			{
 				Name:        "requested_for",
 				Description: "The identity on whose behalf the build was queued.",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("RequestedFor"),
 			},
+			{
+				Name:        "requested_for_display_name",
+				Description: "The display name of the identity on whose behalf the build was queued.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedFor.DisplayName"),
+			},
+			{
+				Name:        "requested_for_unique_name",
+				Description: "The unique name of the identity on whose behalf the build was queued.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedFor.UniqueName"),
+			},
 			{
 				Name:        "result",
 				Description: "The build result.",
@@ -317,6 +418,35 @@ func listBuilds(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		}
 	}
 
+	if value, ok := d.KeyColumnQuals["definition_id"]; ok {
+		definitions := getQualIntList(value)
+		input.Definitions = &definitions
+	}
+
+	if value, ok := d.KeyColumnQuals["queue_id"]; ok {
+		queues := getQualIntList(value)
+		input.Queues = &queues
+	}
+
+	// The API only filters by repository when both the ID and the type are specified.
+	repositoryID, hasRepositoryID := d.KeyColumnQuals["repository_id"]
+	repositoryType, hasRepositoryType := d.KeyColumnQuals["repository_type"]
+	if hasRepositoryID && hasRepositoryType {
+		id := repositoryID.GetStringValue()
+		repoType := repositoryType.GetStringValue()
+		if id != "" && repoType != "" {
+			input.RepositoryId = &id
+			input.RepositoryType = &repoType
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["requested_for_unique_name"]; ok {
+		requestedFor := value.GetStringValue()
+		if requestedFor != "" {
+			input.RequestedFor = &requestedFor
+		}
+	}
+
 	for {
 		response, err := client.GetBuilds(ctx, input)
 		if err != nil {
@@ -340,3 +470,24 @@ func listBuilds(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 
 	return nil, nil
 }
+
+func buildDurationSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	build := d.HydrateItem.(builds.Build)
+	return secondsBetween(build.StartTime, build.FinishTime), nil
+}
+
+func buildIsRunning(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	build := d.HydrateItem.(builds.Build)
+	return build.StartTime != nil && build.FinishTime == nil, nil
+}
+
+func buildQueueWaitSeconds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	build := d.HydrateItem.(builds.Build)
+
+	// A finished build that never started (e.g. cancelled while queued) has no wait time to report.
+	if build.StartTime == nil && build.FinishTime != nil {
+		return nil, nil
+	}
+
+	return secondsBetween(build.QueueTime, build.StartTime), nil
+}
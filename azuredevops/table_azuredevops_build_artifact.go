@@ -0,0 +1,199 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	builds "github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+type pipelineArtifactManifest struct {
+	Items []pipelineArtifactManifestItem `json:"items"`
+}
+
+type pipelineArtifactManifestItem struct {
+	Path string                           `json:"path"`
+	Blob pipelineArtifactManifestItemBlob `json:"blob"`
+}
+
+type pipelineArtifactManifestItemBlob struct {
+	Id   string `json:"id"`
+	Size int64  `json:"size"`
+}
+
+func tableAzureDevOpsBuildArtifact(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_artifact",
+		Description: "Represents an artifact produced by an Azure DevOps build.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listBuildArtifacts,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "build_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "ID of the project the build belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("build_id"),
+			},
+			{
+				Name:        "data",
+				Description: "Type-specific data about the artifact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Data"),
+			},
+			{
+				Name:        "download_url",
+				Description: "A link to download the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.DownloadUrl"),
+			},
+			{
+				Name:        "files",
+				Description: "The files contained in the artifact. This is only set if the resource type is PipelineArtifact.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBuildArtifactFiles,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "id",
+				Description: "The artifact ID.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the artifact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "properties",
+				Description: "Type-specific properties of the artifact.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Resource.Properties"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the resource: File container, version control folder, UNC path, etc.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Type"),
+			},
+			{
+				Name:        "source",
+				Description: "The artifact source, which will be the ID of the job that produced this artifact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Source"),
+			},
+			{
+				Name:        "url",
+				Description: "The full http link to the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Url"),
+			},
+		},
+	}
+}
+
+func listBuildArtifacts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	buildID := int(d.KeyColumnQuals["build_id"].GetInt64Value())
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_artifact.listBuildArtifacts", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := builds.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_artifact.listBuildArtifacts", "client_error", err)
+		return nil, err
+	}
+
+	input := builds.GetArtifactsArgs{
+		Project: &projectID,
+		BuildId: &buildID,
+	}
+
+	response, err := client.GetArtifacts(ctx, input)
+	if err != nil {
+		logger.Error("listBuildArtifacts", "list_build_artifacts_error", err)
+		return nil, err
+	}
+
+	for _, artifact := range *response {
+		d.StreamListItem(ctx, artifact)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getBuildArtifactFiles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	buildID := int(d.KeyColumnQuals["build_id"].GetInt64Value())
+	artifact := h.Item.(builds.BuildArtifact)
+
+	// Only pipeline artifacts have a manifest, which is identified by the resource data.
+	resource := artifact.Resource
+	if resource == nil || resource.Type == nil || !strings.EqualFold(*resource.Type, "PipelineArtifact") || resource.Data == nil {
+		return nil, nil
+	}
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_artifact.getBuildArtifactFiles", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := builds.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_artifact.getBuildArtifactFiles", "client_error", err)
+		return nil, err
+	}
+
+	input := builds.GetFileArgs{
+		Project:      &projectID,
+		BuildId:      &buildID,
+		ArtifactName: artifact.Name,
+		FileId:       resource.Data,
+		FileName:     artifact.Name,
+	}
+
+	response, err := client.GetFile(ctx, input)
+	if err != nil {
+		logger.Error("getBuildArtifactFiles", "get_file_error", err)
+		return nil, err
+	}
+	defer response.Close()
+
+	var manifest pipelineArtifactManifest
+	err = json.NewDecoder(response).Decode(&manifest)
+	if err != nil {
+		logger.Error("getBuildArtifactFiles", "unmarshal_error", err)
+		return nil, err
+	}
+
+	return manifest.Items, nil
+}
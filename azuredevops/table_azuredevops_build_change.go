@@ -0,0 +1,197 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+
+	builds "github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsBuildChange(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_change",
+		Description: "Represents a change (commit or changeset) associated with an Azure DevOps build.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listBuildChanges,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "build_id", Require: plugin.Optional},
+				{Name: "from_build_id", Require: plugin.Optional},
+				{Name: "to_build_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "ID of the project the build belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("build_id"),
+			},
+			{
+				Name:        "from_build_id",
+				Description: "The ID of the first build when listing the changes between two builds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("from_build_id"),
+			},
+			{
+				Name:        "to_build_id",
+				Description: "The ID of the last build when listing the changes between two builds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("to_build_id"),
+			},
+			{
+				Name:        "author",
+				Description: "The author of the change.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Author"),
+			},
+			{
+				Name:        "display_uri",
+				Description: "The location of a user-friendly representation of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayUri"),
+			},
+			{
+				Name:        "id",
+				Description: "The identifier for the change. For a commit, this would be the SHA1. For a TFVC changeset, this would be the changeset ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "location",
+				Description: "The location of the full representation of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location"),
+			},
+			{
+				Name:        "message",
+				Description: "The description of the change. This might be a commit message or changeset description.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Message"),
+			},
+			{
+				Name:        "message_truncated",
+				Description: "Indicates whether the message was truncated.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MessageTruncated"),
+			},
+			{
+				Name:        "pusher",
+				Description: "The person or process that pushed the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Pusher"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The timestamp for the change.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Timestamp.Time"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of change. \"commit\", \"changeset\", etc.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type"),
+			},
+		},
+	}
+}
+
+func listBuildChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_change.listBuildChanges", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := builds.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_change.listBuildChanges", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["build_id"]; ok {
+		buildID := int(value.GetInt64Value())
+		input := builds.GetBuildChangesArgs{
+			Project: &projectID,
+			BuildId: &buildID,
+			Top:     &top,
+		}
+
+		for {
+			response, err := client.GetBuildChanges(ctx, input)
+			if err != nil {
+				logger.Error("listBuildChanges", "list_build_changes_error", err)
+				return nil, err
+			}
+
+			for _, change := range (*response).Value {
+				d.StreamListItem(ctx, change)
+
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			input.ContinuationToken = &response.ContinuationToken
+			if *input.ContinuationToken == "" {
+				break
+			}
+		}
+
+		return nil, nil
+	}
+
+	fromBuildID, hasFromBuildID := d.KeyColumnQuals["from_build_id"]
+	toBuildID, hasToBuildID := d.KeyColumnQuals["to_build_id"]
+	if !hasFromBuildID || !hasToBuildID {
+		return nil, errors.New("azuredevops_build_change requires either build_id or both from_build_id and to_build_id")
+	}
+
+	from := int(fromBuildID.GetInt64Value())
+	to := int(toBuildID.GetInt64Value())
+	input := builds.GetChangesBetweenBuildsArgs{
+		Project:     &projectID,
+		FromBuildId: &from,
+		ToBuildId:   &to,
+		Top:         &top,
+	}
+
+	response, err := client.GetChangesBetweenBuilds(ctx, input)
+	if err != nil {
+		logger.Error("listBuildChanges", "list_changes_between_builds_error", err)
+		return nil, err
+	}
+
+	for _, change := range *response {
+		d.StreamListItem(ctx, change)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -140,6 +140,18 @@ func tableAzureDevOpsBuildDefinition(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Queue"),
 			},
+			{
+				Name:        "queue_id",
+				Description: "The ID of the default queue for builds run against this definition.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Queue.Id"),
+			},
+			{
+				Name:        "queue_name",
+				Description: "The name of the default queue for builds run against this definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Queue.Name"),
+			},
 			{
 				Name:        "badge_enabled",
 				Description: "Indicates whether badges are enabled for this definition.",
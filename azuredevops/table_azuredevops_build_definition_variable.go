@@ -0,0 +1,148 @@
+package azuredevops
+
+import (
+	"context"
+
+	builds "github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// buildDefinitionVariable is a single variable of a build definition, along
+// with a reference to the definition it belongs to.
+type buildDefinitionVariable struct {
+	DefinitionID   *int
+	DefinitionName *string
+	Name           string
+	AllowOverride  *bool
+	IsSecret       *bool
+	Value          *string
+}
+
+func tableAzureDevOpsBuildDefinitionVariable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_definition_variable",
+		Description: "Represents a variable defined on an Azure DevOps build definition.",
+
+		List: &plugin.ListConfig{
+			ParentHydrate: listBuildDefinitions,
+			Hydrate:       listBuildDefinitionVariables,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "allow_override",
+				Description: "Indicates whether the value can be set at queue time.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AllowOverride"),
+			},
+			{
+				Name:        "definition_id",
+				Description: "The ID of the build definition the variable belongs to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DefinitionID"),
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the build definition the variable belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DefinitionName"),
+			},
+			{
+				Name:        "is_secret",
+				Description: "Indicates whether the variable's value is a secret.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsSecret"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the variable.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "plaintext_secret_score",
+				Description: "How likely it is that a non-secret value is a credential, from 0 to 100, based on the variable name, the entropy of the value and known token prefixes. Null for secret variables.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(buildDefinitionVariableSecretScore),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the build definition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "value",
+				Description: "The value of the variable. Null for secret variables.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(buildDefinitionVariableValue),
+			},
+			{
+				Name:        "value_entropy",
+				Description: "The Shannon entropy of the value in bits per character. Null for secret variables.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(buildDefinitionVariableEntropy),
+			},
+		},
+	}
+}
+
+func listBuildDefinitionVariables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	definition := h.Item.(builds.BuildDefinition)
+
+	if definition.Variables == nil {
+		return nil, nil
+	}
+
+	for name, variable := range *definition.Variables {
+		d.StreamListItem(ctx, buildDefinitionVariable{
+			DefinitionID:   definition.Id,
+			DefinitionName: definition.Name,
+			Name:           name,
+			AllowOverride:  variable.AllowOverride,
+			IsSecret:       variable.IsSecret,
+			Value:          variable.Value,
+		})
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func buildDefinitionVariableValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	variable := d.HydrateItem.(buildDefinitionVariable)
+
+	if variable.Value == nil || (variable.IsSecret != nil && *variable.IsSecret) {
+		return nil, nil
+	}
+
+	return *variable.Value, nil
+}
+
+func buildDefinitionVariableSecretScore(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	variable := d.HydrateItem.(buildDefinitionVariable)
+
+	if variable.Value == nil || (variable.IsSecret != nil && *variable.IsSecret) {
+		return nil, nil
+	}
+
+	return plaintextSecretScore(variable.Name, *variable.Value), nil
+}
+
+func buildDefinitionVariableEntropy(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	variable := d.HydrateItem.(buildDefinitionVariable)
+
+	if variable.Value == nil || (variable.IsSecret != nil && *variable.IsSecret) {
+		return nil, nil
+	}
+
+	return shannonEntropy(*variable.Value), nil
+}
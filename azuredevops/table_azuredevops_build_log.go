@@ -0,0 +1,202 @@
+package azuredevops
+
+import (
+	"context"
+	"io"
+
+	builds "github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+const defaultMaxBuildLogSize = 10 * 1024 * 1024
+
+func tableAzureDevOpsBuildLog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_log",
+		Description: "Represents a log file produced by an Azure DevOps build.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listBuildLogs,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "build_id", Require: plugin.Required},
+				{Name: "log_id", Require: plugin.Optional},
+				{Name: "start_line", Require: plugin.Optional},
+				{Name: "end_line", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "ID of the project the build belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("build_id"),
+			},
+			{
+				Name:        "log_id",
+				Description: "The ID of the log.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "content",
+				Description: "The content of the log, limited to the requested line range and the configured max_build_log_size.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBuildLogContent,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "created_on",
+				Description: "The date and time the log was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "end_line",
+				Description: "The last line of the log to return in content.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("end_line"),
+			},
+			{
+				Name:        "last_changed_on",
+				Description: "The date and time the log was last changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastChangedOn.Time"),
+			},
+			{
+				Name:        "line_count",
+				Description: "The number of lines in the log.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("LineCount"),
+			},
+			{
+				Name:        "start_line",
+				Description: "The first line of the log to return in content.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("start_line"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the log location.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type"),
+			},
+			{
+				Name:        "url",
+				Description: "A full link to the log resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+		},
+	}
+}
+
+func listBuildLogs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	buildID := int(d.KeyColumnQuals["build_id"].GetInt64Value())
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_log.listBuildLogs", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := builds.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_log.listBuildLogs", "client_error", err)
+		return nil, err
+	}
+
+	input := builds.GetBuildLogsArgs{
+		Project: &projectID,
+		BuildId: &buildID,
+	}
+
+	response, err := client.GetBuildLogs(ctx, input)
+	if err != nil {
+		logger.Error("listBuildLogs", "list_build_logs_error", err)
+		return nil, err
+	}
+
+	logID, filterByLogID := d.KeyColumnQuals["log_id"]
+
+	for _, log := range *response {
+		if filterByLogID && (log.Id == nil || int64(*log.Id) != logID.GetInt64Value()) {
+			continue
+		}
+
+		d.StreamListItem(ctx, log)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getBuildLogContent(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	buildID := int(d.KeyColumnQuals["build_id"].GetInt64Value())
+	log := h.Item.(builds.BuildLog)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_log.getBuildLogContent", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := builds.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_log.getBuildLogContent", "client_error", err)
+		return nil, err
+	}
+
+	input := builds.GetBuildLogArgs{
+		Project: &projectID,
+		BuildId: &buildID,
+		LogId:   log.Id,
+	}
+
+	if value, ok := d.KeyColumnQuals["start_line"]; ok {
+		startLine := uint64(value.GetInt64Value())
+		input.StartLine = &startLine
+	}
+
+	if value, ok := d.KeyColumnQuals["end_line"]; ok {
+		endLine := uint64(value.GetInt64Value())
+		input.EndLine = &endLine
+	}
+
+	response, err := client.GetBuildLog(ctx, input)
+	if err != nil {
+		logger.Error("getBuildLogContent", "get_build_log_error", err)
+		return nil, err
+	}
+	defer response.Close()
+
+	maxSize := int64(defaultMaxBuildLogSize)
+	config := GetConfig(d.Connection)
+	if config.MaxBuildLogSize != nil && *config.MaxBuildLogSize > 0 {
+		maxSize = int64(*config.MaxBuildLogSize)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(response, maxSize))
+	if err != nil {
+		logger.Error("getBuildLogContent", "read_error", err)
+		return nil, err
+	}
+
+	return string(content), nil
+}
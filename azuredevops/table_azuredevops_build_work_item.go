@@ -0,0 +1,222 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	builds "github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsBuildWorkItem(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_build_work_item",
+		Description: "Represents a work item associated with an Azure DevOps build.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listBuildWorkItems,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "build_id", Require: plugin.Optional},
+				{Name: "from_build_id", Require: plugin.Optional},
+				{Name: "to_build_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Description: "ID of the project the build belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "build_id",
+				Description: "The ID of the build.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("build_id"),
+			},
+			{
+				Name:        "from_build_id",
+				Description: "The ID of the first build when listing the work items between two builds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("from_build_id"),
+			},
+			{
+				Name:        "to_build_id",
+				Description: "The ID of the last build when listing the work items between two builds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("to_build_id"),
+			},
+			{
+				Name:        "id",
+				Description: "The work item ID.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id").Transform(transform.ToInt),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the work item.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBuildWorkItem,
+				Transform:   transform.FromP(workItemFieldValue, "System.State"),
+			},
+			{
+				Name:        "title",
+				Description: "The title of the work item. Null if the work item was deleted or cannot be read.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBuildWorkItem,
+				Transform:   transform.FromP(workItemFieldValue, "System.Title"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST URL of the work item.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+			{
+				Name:        "work_item_type",
+				Description: "The type of the work item.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getBuildWorkItem,
+				Transform:   transform.FromP(workItemFieldValue, "System.WorkItemType"),
+			},
+		},
+	}
+}
+
+func listBuildWorkItems(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_work_item.listBuildWorkItems", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := builds.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_work_item.listBuildWorkItems", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	var response *[]webapi.ResourceRef
+
+	if value, ok := d.KeyColumnQuals["build_id"]; ok {
+		buildID := int(value.GetInt64Value())
+		input := builds.GetBuildWorkItemsRefsArgs{
+			Project: &projectID,
+			BuildId: &buildID,
+			Top:     &top,
+		}
+
+		response, err = client.GetBuildWorkItemsRefs(ctx, input)
+		if err != nil {
+			logger.Error("listBuildWorkItems", "list_build_work_items_error", err)
+			return nil, err
+		}
+	} else {
+		fromBuildID, hasFromBuildID := d.KeyColumnQuals["from_build_id"]
+		toBuildID, hasToBuildID := d.KeyColumnQuals["to_build_id"]
+		if !hasFromBuildID || !hasToBuildID {
+			return nil, errors.New("azuredevops_build_work_item requires either build_id or both from_build_id and to_build_id")
+		}
+
+		from := int(fromBuildID.GetInt64Value())
+		to := int(toBuildID.GetInt64Value())
+		input := builds.GetWorkItemsBetweenBuildsArgs{
+			Project:     &projectID,
+			FromBuildId: &from,
+			ToBuildId:   &to,
+			Top:         &top,
+		}
+
+		response, err = client.GetWorkItemsBetweenBuilds(ctx, input)
+		if err != nil {
+			logger.Error("listBuildWorkItems", "list_work_items_between_builds_error", err)
+			return nil, err
+		}
+	}
+
+	for _, workItem := range *response {
+		d.StreamListItem(ctx, workItem)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getBuildWorkItem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	ref := h.Item.(webapi.ResourceRef)
+
+	if ref.Id == nil {
+		return nil, nil
+	}
+
+	id, err := strconv.Atoi(*ref.Id)
+	if err != nil {
+		logger.Error("azuredevops_build_work_item.getBuildWorkItem", "parse_id_error", err)
+		return nil, err
+	}
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_build_work_item.getBuildWorkItem", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := workitemtracking.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_build_work_item.getBuildWorkItem", "client_error", err)
+		return nil, err
+	}
+
+	fields := []string{"System.Title", "System.State", "System.WorkItemType"}
+	input := workitemtracking.GetWorkItemArgs{
+		Id:      &id,
+		Project: &projectID,
+		Fields:  &fields,
+	}
+
+	workItem, err := client.GetWorkItem(ctx, input)
+	if err != nil {
+		// A single deleted or inaccessible work item should not fail the
+		// whole query, so its details are left empty instead.
+		if isNotFoundOrForbiddenError(err) {
+			return nil, nil
+		}
+
+		logger.Error("getBuildWorkItem", "get_work_item_error", err)
+		return nil, err
+	}
+
+	return workItem, nil
+}
+
+func workItemFieldValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	workItem, ok := d.HydrateItem.(*workitemtracking.WorkItem)
+	if !ok || workItem == nil || workItem.Fields == nil {
+		return nil, nil
+	}
+
+	return (*workItem.Fields)[d.Param.(string)], nil
+}
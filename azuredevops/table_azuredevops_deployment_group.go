@@ -0,0 +1,144 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsDeploymentGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_deployment_group",
+		Description: "Represents an Azure DevOps deployment group used by classic releases.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listDeploymentGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "description",
+				Description: "Description of the deployment group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "id",
+				Description: "Deployment group identifier.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "machine_count",
+				Description: "Number of deployment targets in the deployment group.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("MachineCount"),
+			},
+			{
+				Name:        "machine_tags",
+				Description: "List of unique tags across all deployment targets in the deployment group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MachineTags"),
+			},
+			{
+				Name:        "name",
+				Description: "Name of the deployment group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "pool",
+				Description: "Deployment pool in which deployment agents are registered.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Pool"),
+			},
+			{
+				Name:        "pool_id",
+				Description: "ID of the deployment pool in which deployment agents are registered.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Pool.Id"),
+			},
+			{
+				Name:        "pool_name",
+				Description: "Name of the deployment pool in which deployment agents are registered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Pool.Name"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the deployment group belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+		},
+	}
+}
+
+func listDeploymentGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_deployment_group.listDeploymentGroups", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_deployment_group.listDeploymentGroups", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	expand := taskagent.DeploymentGroupExpandsValues.Tags
+	input := taskagent.GetDeploymentGroupsArgs{
+		Project: &projectID,
+		Expand:  &expand,
+		Top:     &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.Name = &name
+		}
+	}
+
+	for {
+		response, err := client.GetDeploymentGroups(ctx, input)
+		if err != nil {
+			logger.Error("listDeploymentGroups", "list_deployment_groups_error", err)
+			return nil, err
+		}
+
+		for _, group := range (*response).Value {
+			d.StreamListItem(ctx, group)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		input.ContinuationToken = &response.ContinuationToken
+		if *input.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,209 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsDeploymentTarget(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_deployment_target",
+		Description: "Represents a deployment target (machine) registered in an Azure DevOps deployment group.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listDeploymentTargets,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "deployment_group_id", Require: plugin.Required},
+				{Name: "agent_status", Require: plugin.Optional},
+				{Name: "enabled", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "agent",
+				Description: "Deployment agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Agent"),
+			},
+			{
+				Name:        "agent_id",
+				Description: "Identifier of the deployment agent.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Agent.Id"),
+			},
+			{
+				Name:        "agent_status",
+				Description: "Whether or not the deployment agent is online.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Agent.Status"),
+			},
+			{
+				Name:        "agent_version",
+				Description: "Version of the deployment agent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Agent.Version"),
+			},
+			{
+				Name:        "deployment_group_id",
+				Description: "ID of the deployment group the target belongs to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("deployment_group_id"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Whether or not the deployment agent should run jobs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Agent.Enabled"),
+			},
+			{
+				Name:        "id",
+				Description: "Deployment target identifier.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "last_completed_request",
+				Description: "The last request which was completed by the deployment agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Agent.LastCompletedRequest"),
+			},
+			{
+				Name:        "last_job_finish_time",
+				Description: "The date/time the last completed request finished.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Agent.LastCompletedRequest.FinishTime.Time"),
+			},
+			{
+				Name:        "last_job_result",
+				Description: "The result of the last completed request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Agent.LastCompletedRequest.Result"),
+			},
+			{
+				Name:        "name",
+				Description: "Name of the deployment target.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Agent.Name"),
+			},
+			{
+				Name:        "os_description",
+				Description: "Deployment agent OS.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Agent.OsDescription"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the deployment group belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "properties",
+				Description: "Properties of the deployment target.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties"),
+			},
+			{
+				Name:        "status_changed_on",
+				Description: "Date on which the last connectivity status change occurred.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Agent.StatusChangedOn.Time"),
+			},
+			{
+				Name:        "tags",
+				Description: "Tags of the deployment target.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+		},
+	}
+}
+
+func listDeploymentTargets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	deploymentGroupID := int(d.KeyColumnQuals["deployment_group_id"].GetInt64Value())
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_deployment_target.listDeploymentTargets", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_deployment_target.listDeploymentTargets", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	input := taskagent.GetDeploymentTargetsArgs{
+		Project:           &projectID,
+		DeploymentGroupId: &deploymentGroupID,
+		Top:               &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	// The last completed request is only returned when expanded, so only ask for it when selected.
+	if isColumnRequested(d, "last_completed_request") ||
+		isColumnRequested(d, "last_job_finish_time") ||
+		isColumnRequested(d, "last_job_result") {
+		expand := taskagent.DeploymentTargetExpandsValues.LastCompletedRequest
+		input.Expand = &expand
+	}
+
+	if value, ok := d.KeyColumnQuals["agent_status"]; ok {
+		status := taskagent.TaskAgentStatusFilter(value.GetStringValue())
+		if status != "" {
+			input.AgentStatus = &status
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["enabled"]; ok {
+		enabled := value.GetBoolValue()
+		input.Enabled = &enabled
+	}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.Name = &name
+		}
+	}
+
+	for {
+		response, err := client.GetDeploymentTargets(ctx, input)
+		if err != nil {
+			logger.Error("listDeploymentTargets", "list_deployment_targets_error", err)
+			return nil, err
+		}
+
+		for _, target := range (*response).Value {
+			d.StreamListItem(ctx, target)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		input.ContinuationToken = &response.ContinuationToken
+		if *input.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,176 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsEnvironment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_environment",
+		Description: "Represents an Azure DevOps environment targeted by YAML pipelines.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listEnvironments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "created_by",
+				Description: "Identity reference of the user who created the environment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_on",
+				Description: "Creation time of the environment.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "Description of the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "id",
+				Description: "ID of the environment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "Identity reference of the user who last modified the environment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LastModifiedBy"),
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "Last modified time of the environment.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedOn.Time"),
+			},
+			{
+				Name:        "name",
+				Description: "Name of the environment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this environment belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "resources",
+				Description: "The resources (e.g. Kubernetes namespaces and virtual machines) registered in the environment.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getEnvironmentResources,
+				Transform:   transform.FromValue(),
+			},
+		},
+	}
+}
+
+func listEnvironments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_environment.listEnvironments", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_environment.listEnvironments", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	input := taskagent.GetEnvironmentsArgs{
+		Project: &projectID,
+		Top:     &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.Name = &name
+		}
+	}
+
+	for {
+		response, err := client.GetEnvironments(ctx, input)
+		if err != nil {
+			logger.Error("listEnvironments", "list_environments_error", err)
+			return nil, err
+		}
+
+		for _, environment := range (*response).Value {
+			d.StreamListItem(ctx, environment)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		input.ContinuationToken = &response.ContinuationToken
+		if *input.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+func getEnvironmentResources(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	environment := h.Item.(taskagent.EnvironmentInstance)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_environment.getEnvironmentResources", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_environment.getEnvironmentResources", "client_error", err)
+		return nil, err
+	}
+
+	expands := taskagent.EnvironmentExpandsValues.ResourceReferences
+	input := taskagent.GetEnvironmentByIdArgs{
+		Project:       &projectID,
+		EnvironmentId: environment.Id,
+		Expands:       &expands,
+	}
+
+	response, err := client.GetEnvironmentById(ctx, input)
+	if err != nil {
+		logger.Error("getEnvironmentResources", "get_environment_error", err)
+		return nil, err
+	}
+
+	return response.Resources, nil
+}
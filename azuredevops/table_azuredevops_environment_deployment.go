@@ -0,0 +1,215 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsEnvironmentDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_environment_deployment",
+		Description: "Represents a deployment execution record of an Azure DevOps environment.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listEnvironmentDeployments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "environment_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "definition",
+				Description: "Definition of the environment deployment execution owner.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Definition"),
+			},
+			{
+				Name:        "environment_id",
+				Description: "ID of the environment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("EnvironmentId"),
+			},
+			{
+				Name:        "finish_time",
+				Description: "Finish time of the environment deployment execution.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("FinishTime.Time"),
+			},
+			{
+				Name:        "id",
+				Description: "ID of the environment deployment execution history record.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "job_attempt",
+				Description: "Job attempt.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("JobAttempt"),
+			},
+			{
+				Name:        "job_name",
+				Description: "Job name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("JobName"),
+			},
+			{
+				Name:        "owner",
+				Description: "Owner of the environment deployment execution record.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Owner"),
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "ID of the pipeline that performed the deployment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Definition.Id"),
+			},
+			{
+				Name:        "pipeline_name",
+				Description: "Name of the pipeline that performed the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Definition.Name"),
+			},
+			{
+				Name:        "plan_id",
+				Description: "Plan ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlanId"),
+			},
+			{
+				Name:        "plan_type",
+				Description: "Plan type of the environment deployment execution record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PlanType"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this environment belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "queue_time",
+				Description: "Queue time of the environment deployment execution.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueueTime.Time"),
+			},
+			{
+				Name:        "request_identifier",
+				Description: "Request identifier of the environment deployment execution history record.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestIdentifier"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "ID of the environment resource that was deployed to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ResourceId"),
+			},
+			{
+				Name:        "result",
+				Description: "Result of the environment deployment execution.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result"),
+			},
+			{
+				Name:        "run_id",
+				Description: "ID of the pipeline run that performed the deployment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Owner.Id"),
+			},
+			{
+				Name:        "run_name",
+				Description: "Name of the pipeline run that performed the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner.Name"),
+			},
+			{
+				Name:        "service_owner",
+				Description: "Service owner ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceOwner"),
+			},
+			{
+				Name:        "stage_attempt",
+				Description: "Stage attempt.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StageAttempt"),
+			},
+			{
+				Name:        "stage_name",
+				Description: "Stage name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("StageName"),
+			},
+			{
+				Name:        "start_time",
+				Description: "Start time of the environment deployment execution.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartTime.Time"),
+			},
+		},
+	}
+}
+
+func listEnvironmentDeployments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	environmentID := int(d.KeyColumnQuals["environment_id"].GetInt64Value())
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_environment_deployment.listEnvironmentDeployments", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_environment_deployment.listEnvironmentDeployments", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	input := taskagent.GetEnvironmentDeploymentExecutionRecordsArgs{
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+		Top:           &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	for {
+		response, err := client.GetEnvironmentDeploymentExecutionRecords(ctx, input)
+		if err != nil {
+			logger.Error("listEnvironmentDeployments", "list_environment_deployments_error", err)
+			return nil, err
+		}
+
+		for _, record := range (*response).Value {
+			d.StreamListItem(ctx, record)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		input.ContinuationToken = &response.ContinuationToken
+		if *input.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
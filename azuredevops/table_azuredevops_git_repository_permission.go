@@ -0,0 +1,350 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// gitRepositoriesNamespaceID is the ID of the Git Repositories security namespace.
+var gitRepositoriesNamespaceID = uuid.MustParse("2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87")
+
+// gitRepositoryTokenPrefix is the first element of all Git Repositories security tokens.
+const gitRepositoryTokenPrefix = "repoV2"
+
+// gitRepositoryPermission is the state of a single Git Repositories action
+// for an identity on a project, repository or ref.
+type gitRepositoryPermission struct {
+	accessControlEntry
+	ProjectID    *string
+	RepositoryID *string
+	RefName      *string
+	BranchName   *string
+	Action       security.ActionDefinition
+}
+
+func tableAzureDevOpsGitRepositoryPermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_git_repository_permission",
+		Description: "Represents a permission granted or denied to an identity on Azure DevOps git repositories, a single repository or a branch.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listGitRepositoryPermissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "repository_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "allow",
+				Description: "Whether the permission is explicitly allowed on the token.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(gitRepositoryPermissionBit, "allow"),
+			},
+			{
+				Name:        "branch_name",
+				Description: "The name of the branch the permission applies to, e.g. main. Null for repository and project permissions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BranchName"),
+			},
+			{
+				Name:        "deny",
+				Description: "Whether the permission is explicitly denied on the token.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(gitRepositoryPermissionBit, "deny"),
+			},
+			{
+				Name:        "descriptor",
+				Description: "The identity descriptor the permission applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Descriptor"),
+			},
+			{
+				Name:        "effective_allow",
+				Description: "Whether the permission is allowed, including inherited permissions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(gitRepositoryPermissionBit, "effective_allow"),
+			},
+			{
+				Name:        "effective_deny",
+				Description: "Whether the permission is denied, including inherited permissions.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(gitRepositoryPermissionBit, "effective_deny"),
+			},
+			{
+				Name:        "identity_display_name",
+				Description: "The display name of the identity the permission applies to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(gitRepositoryPermissionIdentityDisplayName),
+			},
+			{
+				Name:        "identity_is_container",
+				Description: "Whether the identity the permission applies to is a group.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Identity.IsContainer"),
+			},
+			{
+				Name:        "identity_subject_descriptor",
+				Description: "The subject descriptor of the identity the permission applies to, as used by the Graph APIs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Identity.SubjectDescriptor"),
+			},
+			{
+				Name:        "inherit_permissions",
+				Description: "Whether the token inherits permissions from its parent.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("InheritPermissions"),
+			},
+			{
+				Name:        "permission",
+				Description: "The name of the permission, e.g. ForcePush or PolicyExempt.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Action.Name"),
+			},
+			{
+				Name:        "permission_bit",
+				Description: "The bit of the permission in the Git Repositories namespace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Action.Bit"),
+			},
+			{
+				Name:        "permission_display_name",
+				Description: "The display name of the permission, e.g. Force push (rewrite history, delete branches and tags).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Action.DisplayName"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the permission applies to. Null for organization wide permissions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+			},
+			{
+				Name:        "ref_name",
+				Description: "The full name of the ref the permission applies to, e.g. refs/heads/main. Null for repository and project permissions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RefName"),
+			},
+			{
+				Name:        "repository_id",
+				Description: "ID of the repository the permission applies to. Null for project and organization wide permissions.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RepositoryID"),
+			},
+			{
+				Name:        "token",
+				Description: "The raw security token the permission is set on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Token"),
+			},
+		},
+	}
+}
+
+func listGitRepositoryPermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	namespaceID := gitRepositoriesNamespaceID
+	input := security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &namespaceID,
+	}
+
+	// Narrow the query down to the ACLs of the project or repository, and
+	// everything below them, when they are known.
+	projectID := ""
+	if value, ok := d.KeyColumnQuals["project_id"]; ok {
+		projectID = value.GetStringValue()
+	}
+
+	repositoryID := ""
+	if value, ok := d.KeyColumnQuals["repository_id"]; ok {
+		repositoryID = value.GetStringValue()
+	}
+
+	// Repository tokens include the project, so look it up when only the
+	// repository is known rather than querying the whole namespace.
+	if projectID == "" && repositoryID != "" {
+		connection, err := GetAzureDevOpsConnection(ctx, d)
+		if err != nil {
+			logger.Error("azuredevops_git_repository_permission.listGitRepositoryPermissions", "connection_error", err)
+			return nil, err
+		}
+
+		client, err := git.NewClient(ctx, connection)
+		if err != nil {
+			logger.Error("azuredevops_git_repository_permission.listGitRepositoryPermissions", "client_error", err)
+			return nil, err
+		}
+
+		repository, err := client.GetRepository(ctx, git.GetRepositoryArgs{RepositoryId: &repositoryID})
+		if err != nil {
+			logger.Error("listGitRepositoryPermissions", "get_repository_error", err)
+			return nil, err
+		}
+
+		if repository.Project != nil && repository.Project.Id != nil {
+			projectID = repository.Project.Id.String()
+		}
+	}
+
+	if projectID != "" {
+		token := gitRepositoryTokenPrefix + "/" + projectID
+		if repositoryID != "" {
+			token += "/" + repositoryID
+		}
+
+		recurse := true
+		input.Token = &token
+		input.Recurse = &recurse
+	}
+
+	// Resolving identities takes extra requests, so only do it when they are selected.
+	resolveIdentities := isColumnRequested(d, "identity_display_name") ||
+		isColumnRequested(d, "identity_is_container") ||
+		isColumnRequested(d, "identity_subject_descriptor")
+
+	entries, err := queryAccessControlEntries(ctx, d, input, resolveIdentities)
+	if err != nil {
+		logger.Error("listGitRepositoryPermissions", "query_access_control_entries_error", err)
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Token == nil {
+			continue
+		}
+
+		projectID, repositoryID, refName := parseGitRepositoryToken(*entry.Token)
+
+		var branchName *string
+		if refName != nil && strings.HasPrefix(*refName, "refs/heads/") {
+			name := strings.TrimPrefix(*refName, "refs/heads/")
+			branchName = &name
+		}
+
+		for _, action := range entry.actions {
+			if action.Bit == nil || !isGitRepositoryPermissionSet(entry, *action.Bit) {
+				continue
+			}
+
+			d.StreamListItem(ctx, gitRepositoryPermission{
+				accessControlEntry: entry,
+				ProjectID:          projectID,
+				RepositoryID:       repositoryID,
+				RefName:            refName,
+				BranchName:         branchName,
+				Action:             action,
+			})
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// parseGitRepositoryToken splits a Git Repositories security token, e.g.
+// repoV2/{projectId}/{repositoryId}/refs/heads/{branch}, into its project ID,
+// repository ID and ref name. Elements which are not present are nil.
+func parseGitRepositoryToken(token string) (*string, *string, *string) {
+	var projectID, repositoryID, refName *string
+
+	parts := strings.Split(token, "/")
+	if len(parts) < 2 || parts[0] != gitRepositoryTokenPrefix {
+		return nil, nil, nil
+	}
+
+	projectID = &parts[1]
+	if len(parts) > 2 {
+		repositoryID = &parts[2]
+	}
+
+	if len(parts) > 3 {
+		// Each element of the ref name below refs/ is hex encoded UTF-16.
+		elements := make([]string, 0, len(parts)-3)
+		for _, element := range parts[3:] {
+			elements = append(elements, decodeGitRefTokenElement(element))
+		}
+
+		name := strings.Join(elements, "/")
+		refName = &name
+	}
+
+	return projectID, repositoryID, refName
+}
+
+// decodeGitRefTokenElement decodes a hex encoded UTF-16LE element of a ref
+// token. Elements which are not encoded, such as refs and heads, are returned
+// as is.
+func decodeGitRefTokenElement(element string) string {
+	bytes, err := hex.DecodeString(element)
+	if err != nil || len(bytes) == 0 || len(bytes)%2 != 0 {
+		return element
+	}
+
+	units := make([]uint16, 0, len(bytes)/2)
+	for i := 0; i < len(bytes); i += 2 {
+		units = append(units, uint16(bytes[i])|uint16(bytes[i+1])<<8)
+	}
+
+	return string(utf16.Decode(units))
+}
+
+func isGitRepositoryPermissionSet(entry accessControlEntry, bit int) bool {
+	masks := []*int{entry.Allow, entry.Deny}
+	if entry.ExtendedInfo != nil {
+		masks = append(masks, entry.ExtendedInfo.EffectiveAllow, entry.ExtendedInfo.EffectiveDeny)
+	}
+
+	for _, mask := range masks {
+		if mask != nil && *mask&bit != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func gitRepositoryPermissionBit(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permission := d.HydrateItem.(gitRepositoryPermission)
+	if permission.Action.Bit == nil {
+		return nil, nil
+	}
+
+	var mask *int
+	switch d.Param.(string) {
+	case "allow":
+		mask = permission.Allow
+	case "deny":
+		mask = permission.Deny
+	case "effective_allow":
+		mask = permission.Allow
+		if permission.ExtendedInfo != nil && permission.ExtendedInfo.EffectiveAllow != nil {
+			mask = permission.ExtendedInfo.EffectiveAllow
+		}
+	case "effective_deny":
+		mask = permission.Deny
+		if permission.ExtendedInfo != nil && permission.ExtendedInfo.EffectiveDeny != nil {
+			mask = permission.ExtendedInfo.EffectiveDeny
+		}
+	}
+
+	return mask != nil && *mask&*permission.Action.Bit != 0, nil
+}
+
+func gitRepositoryPermissionIdentityDisplayName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permission := d.HydrateItem.(gitRepositoryPermission)
+	return identityDisplayName(permission.Identity), nil
+}
@@ -0,0 +1,186 @@
+package azuredevops
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// projectGroupDomainPrefix is the prefix of the domain of groups which are
+// scoped to a project, it is followed by the project ID.
+const projectGroupDomainPrefix = "vstfs:///Classification/TeamProject/"
+
+func tableAzureDevOpsGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_group",
+		Description: "Represents an Azure DevOps or Azure Active Directory group known to the organization.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "scope_descriptor", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "descriptor",
+				Description: "The descriptor of the group, used to reference it in the Graph APIs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Descriptor"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the group, e.g. [MyProject]\\Project Administrators.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "domain",
+				Description: "The domain of the group, e.g. the project or organization it belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Domain"),
+			},
+			{
+				Name:        "legacy_descriptor",
+				Description: "The legacy identity descriptor of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LegacyDescriptor"),
+			},
+			{
+				Name:        "mail_address",
+				Description: "The email address of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MailAddress"),
+			},
+			{
+				Name:        "origin",
+				Description: "The type of source provider of the group, e.g. vsts or aad.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Origin"),
+			},
+			{
+				Name:        "origin_id",
+				Description: "The ID of the group in the source provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OriginId"),
+			},
+			{
+				Name:        "principal_name",
+				Description: "The principal name of the group, e.g. [MyProject]\\Project Administrators.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrincipalName"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the group belongs to. Null for organization groups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(groupProjectID),
+			},
+			{
+				Name:        "scope_descriptor",
+				Description: "The descriptor of the scope (organization or project) the groups were listed from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("scope_descriptor"),
+			},
+			{
+				Name:        "subject_kind",
+				Description: "The kind of the graph subject.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubjectKind"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST API URL of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+		},
+	}
+}
+
+func listGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_group.listGroups", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := graph.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_group.listGroups", "client_error", err)
+		return nil, err
+	}
+
+	input := graph.ListGroupsArgs{}
+
+	if value, ok := d.KeyColumnQuals["scope_descriptor"]; ok {
+		scopeDescriptor := value.GetStringValue()
+		if scopeDescriptor != "" {
+			input.ScopeDescriptor = &scopeDescriptor
+		}
+	} else if value, ok := d.KeyColumnQuals["project_id"]; ok {
+		projectID, err := uuid.Parse(value.GetStringValue())
+		if err != nil {
+			logger.Error("listGroups", "parse_project_id_error", err)
+			return nil, err
+		}
+
+		// Groups are listed by scope, so resolve the project to its scope descriptor.
+		descriptor, err := client.GetDescriptor(ctx, graph.GetDescriptorArgs{StorageKey: &projectID})
+		if err != nil {
+			logger.Error("listGroups", "get_descriptor_error", err)
+			return nil, err
+		}
+		input.ScopeDescriptor = descriptor.Value
+	}
+
+	for {
+		response, err := client.ListGroups(ctx, input)
+		if err != nil {
+			logger.Error("listGroups", "list_groups_error", err)
+			return nil, err
+		}
+
+		if response.GraphGroups != nil {
+			for _, group := range *response.GraphGroups {
+				d.StreamListItem(ctx, group)
+
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if response.ContinuationToken == nil || len(*response.ContinuationToken) == 0 || (*response.ContinuationToken)[0] == "" {
+			break
+		}
+
+		input.ContinuationToken = &(*response.ContinuationToken)[0]
+	}
+
+	return nil, nil
+}
+
+func groupProjectID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group := d.HydrateItem.(graph.GraphGroup)
+	if group.Domain == nil || !strings.HasPrefix(*group.Domain, projectGroupDomainPrefix) {
+		return nil, nil
+	}
+
+	return strings.TrimPrefix(*group.Domain, projectGroupDomainPrefix), nil
+}
@@ -0,0 +1,285 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// groupDescriptorPrefixes are the prefixes of the descriptors of subjects
+// which can have members, and so are expanded in transitive mode.
+var groupDescriptorPrefixes = []string{"vssgp.", "aadgp."}
+
+// subjectLookupBatchSize is the number of subject descriptors resolved per request.
+const subjectLookupBatchSize = 50
+
+// groupMembership is a membership found while walking the group hierarchy
+// from the subject in the query.
+type groupMembership struct {
+	graph.GraphMembership
+	SubjectDescriptor string
+	Direction         graph.GraphTraversalDirection
+	Depth             int
+	Transitive        bool
+	Container         *graph.GraphSubject
+	Member            *graph.GraphSubject
+}
+
+func tableAzureDevOpsGroupMembership(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_group_membership",
+		Description: "Represents a membership of a user or group in an Azure DevOps group.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listGroupMemberships,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "subject_descriptor", Require: plugin.Required},
+				{Name: "direction", Require: plugin.Optional},
+				{Name: "transitive", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "container_descriptor",
+				Description: "The descriptor of the group which contains the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ContainerDescriptor"),
+			},
+			{
+				Name:        "container_display_name",
+				Description: "The display name of the group which contains the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Container.DisplayName"),
+			},
+			{
+				Name:        "depth",
+				Description: "The number of levels between the subject and this membership. Direct memberships have a depth of 1.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Depth"),
+			},
+			{
+				Name:        "direction",
+				Description: "The direction the hierarchy was traversed from the subject, either \"up\" (groups the subject is a member of) or \"down\" (members of the subject), in lower case. Defaults to \"up\".",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Direction"),
+			},
+			{
+				Name:        "member_descriptor",
+				Description: "The descriptor of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MemberDescriptor"),
+			},
+			{
+				Name:        "member_display_name",
+				Description: "The display name of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.DisplayName"),
+			},
+			{
+				Name:        "member_origin",
+				Description: "The type of source provider of the member, e.g. vsts or aad.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Origin"),
+			},
+			{
+				Name:        "member_subject_kind",
+				Description: "The kind of the member, e.g. user or group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.SubjectKind"),
+			},
+			{
+				Name:        "subject_descriptor",
+				Description: "The descriptor of the user or group the hierarchy was traversed from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubjectDescriptor"),
+			},
+			{
+				Name:        "transitive",
+				Description: "Whether nested group memberships are expanded. Defaults to false.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Transitive"),
+			},
+		},
+	}
+}
+
+func listGroupMemberships(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	subjectDescriptor := d.KeyColumnQuals["subject_descriptor"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_group_membership.listGroupMemberships", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := graph.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_group_membership.listGroupMemberships", "client_error", err)
+		return nil, err
+	}
+
+	direction := graph.GraphTraversalDirectionValues.Up
+	if value, ok := d.KeyColumnQuals["direction"]; ok {
+		direction = graph.GraphTraversalDirection(value.GetStringValue())
+		if direction != graph.GraphTraversalDirectionValues.Up && direction != graph.GraphTraversalDirectionValues.Down {
+			return nil, fmt.Errorf("azuredevops_group_membership direction must be 'up' or 'down', got '%s'", direction)
+		}
+	}
+
+	transitive := false
+	if value, ok := d.KeyColumnQuals["transitive"]; ok {
+		transitive = value.GetBoolValue()
+	}
+
+	// The API only returns direct memberships, so in transitive mode walk the
+	// hierarchy breadth first. Each subject is only expanded once, which both
+	// avoids duplicate work and stops cycles between nested groups.
+	visited := map[string]bool{subjectDescriptor: true}
+	queue := []string{subjectDescriptor}
+
+	// Subjects are only resolved when one of their columns is requested, and
+	// then once per level of the hierarchy rather than once per row.
+	resolveSubjects := isColumnRequested(d, "container_display_name") ||
+		isColumnRequested(d, "member_display_name") ||
+		isColumnRequested(d, "member_origin") ||
+		isColumnRequested(d, "member_subject_kind")
+
+	for depth := 1; len(queue) > 0; depth++ {
+		var next []string
+		var memberships []groupMembership
+
+		for _, descriptor := range queue {
+			descriptor := descriptor
+			input := graph.ListMembershipsArgs{
+				SubjectDescriptor: &descriptor,
+				Direction:         &direction,
+			}
+
+			response, err := client.ListMemberships(ctx, input)
+			if err != nil {
+				logger.Error("listGroupMemberships", "list_memberships_error", err)
+				return nil, err
+			}
+
+			for _, membership := range *response {
+				memberships = append(memberships, groupMembership{
+					GraphMembership:   membership,
+					SubjectDescriptor: subjectDescriptor,
+					Direction:         direction,
+					Depth:             depth,
+					Transitive:        transitive,
+				})
+
+				related := membership.ContainerDescriptor
+				if direction == graph.GraphTraversalDirectionValues.Down {
+					related = membership.MemberDescriptor
+				}
+
+				if transitive && related != nil && !visited[*related] && isGroupDescriptor(*related) {
+					visited[*related] = true
+					next = append(next, *related)
+				}
+			}
+		}
+
+		if resolveSubjects {
+			var descriptors []string
+			for _, membership := range memberships {
+				if membership.ContainerDescriptor != nil {
+					descriptors = append(descriptors, *membership.ContainerDescriptor)
+				}
+				if membership.MemberDescriptor != nil {
+					descriptors = append(descriptors, *membership.MemberDescriptor)
+				}
+			}
+
+			subjects, err := lookupGroupSubjects(ctx, client, descriptors)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range memberships {
+				if memberships[i].ContainerDescriptor != nil {
+					memberships[i].Container = subjects[*memberships[i].ContainerDescriptor]
+				}
+				if memberships[i].MemberDescriptor != nil {
+					memberships[i].Member = subjects[*memberships[i].MemberDescriptor]
+				}
+			}
+		}
+
+		for _, membership := range memberships {
+			d.StreamListItem(ctx, membership)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		queue = next
+	}
+
+	return nil, nil
+}
+
+// lookupGroupSubjects resolves the subjects for the descriptors, keyed by
+// descriptor, in batches of subjectLookupBatchSize.
+func lookupGroupSubjects(ctx context.Context, client graph.Client, descriptors []string) (map[string]*graph.GraphSubject, error) {
+	logger := plugin.Logger(ctx)
+	subjects := make(map[string]*graph.GraphSubject)
+
+	var unique []string
+	seen := make(map[string]bool)
+	for _, descriptor := range descriptors {
+		if !seen[descriptor] {
+			seen[descriptor] = true
+			unique = append(unique, descriptor)
+		}
+	}
+
+	for start := 0; start < len(unique); start += subjectLookupBatchSize {
+		end := start + subjectLookupBatchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+
+		var keys []graph.GraphSubjectLookupKey
+		for _, descriptor := range unique[start:end] {
+			descriptor := descriptor
+			keys = append(keys, graph.GraphSubjectLookupKey{Descriptor: &descriptor})
+		}
+		input := graph.LookupSubjectsArgs{
+			SubjectLookup: &graph.GraphSubjectLookup{LookupKeys: &keys},
+		}
+
+		response, err := client.LookupSubjects(ctx, input)
+		if err != nil {
+			logger.Error("lookupGroupSubjects", "lookup_subjects_error", err)
+			return nil, err
+		}
+
+		for descriptor, subject := range *response {
+			subject := subject
+			subjects[descriptor] = &subject
+		}
+	}
+
+	return subjects, nil
+}
+
+func isGroupDescriptor(descriptor string) bool {
+	for _, prefix := range groupDescriptorPrefixes {
+		if strings.HasPrefix(descriptor, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,207 @@
+package azuredevops
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/delegatedauthorization"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/tokenadmin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// fullAccessTokenScope is the scope of personal access tokens created with full access.
+const fullAccessTokenScope = "app_token"
+
+func tableAzureDevOpsPersonalAccessToken(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_personal_access_token",
+		Description: "Represents a personal access token (PAT) of an Azure DevOps user, as seen by token administrators.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listPersonalAccessTokens,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "subject_descriptor", Require: plugin.Required},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "authorization_id",
+				Description: "The ID of the authorization of the token, used to revoke it.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AuthorizationId"),
+			},
+			{
+				Name:        "client_id",
+				Description: "The ID of the client (application) the token was issued to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClientId"),
+			},
+			{
+				Name:        "days_until_expiry",
+				Description: "The number of whole days until the token expires. Negative if the token has already expired.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(personalAccessTokenDaysUntilExpiry),
+			},
+			{
+				Name:        "display_name",
+				Description: "The name of the token.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "is_full_scope",
+				Description: "Whether the token was created with full access to all scopes.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(personalAccessTokenIsFullScope),
+			},
+			{
+				Name:        "is_valid",
+				Description: "Whether the token is valid, i.e. not revoked.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsValid"),
+			},
+			{
+				Name:        "scope",
+				Description: "The space separated scopes of the token.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Scope"),
+			},
+			{
+				Name:        "scopes",
+				Description: "The scopes of the token, e.g. vso.code_write.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(personalAccessTokenScopes),
+			},
+			{
+				Name:        "subject_descriptor",
+				Description: "The descriptor of the user who owns the token.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("subject_descriptor"),
+			},
+			{
+				Name:        "target_accounts",
+				Description: "The IDs of the organizations the token is valid for. Null if valid for all accessible organizations.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TargetAccounts"),
+			},
+			{
+				Name:        "user_id",
+				Description: "The ID of the user who owns the token.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserId"),
+			},
+			{
+				Name:        "valid_from",
+				Description: "The date the token was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ValidFrom.Time"),
+			},
+			{
+				Name:        "valid_to",
+				Description: "The date the token expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ValidTo.Time"),
+			},
+		},
+	}
+}
+
+func listPersonalAccessTokens(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	subjectDescriptor := d.KeyColumnQuals["subject_descriptor"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_personal_access_token.listPersonalAccessTokens", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := tokenadmin.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_personal_access_token.listPersonalAccessTokens", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	isPublic := false
+	input := tokenadmin.ListPersonalAccessTokensArgs{
+		SubjectDescriptor: &subjectDescriptor,
+		PageSize:          &top,
+		IsPublic:          &isPublic,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	for {
+		response, err := client.ListPersonalAccessTokens(ctx, input)
+		if err != nil {
+			logger.Error("listPersonalAccessTokens", "list_personal_access_tokens_error", err)
+			return nil, err
+		}
+
+		if response.Value != nil {
+			for _, token := range *response.Value {
+				d.StreamListItem(ctx, token)
+
+				if d.QueryStatus.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if response.ContinuationToken == nil || *response.ContinuationToken == uuid.Nil {
+			break
+		}
+
+		continuationToken := response.ContinuationToken.String()
+		input.ContinuationToken = &continuationToken
+	}
+
+	return nil, nil
+}
+
+func personalAccessTokenScopes(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	token := d.HydrateItem.(delegatedauthorization.SessionToken)
+	if token.Scope == nil {
+		return nil, nil
+	}
+
+	return strings.Fields(*token.Scope), nil
+}
+
+func personalAccessTokenIsFullScope(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	token := d.HydrateItem.(delegatedauthorization.SessionToken)
+	if token.Scope == nil {
+		return nil, nil
+	}
+
+	for _, scope := range strings.Fields(*token.Scope) {
+		if scope == fullAccessTokenScope {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func personalAccessTokenDaysUntilExpiry(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	token := d.HydrateItem.(delegatedauthorization.SessionToken)
+	if token.ValidTo == nil {
+		return nil, nil
+	}
+
+	// Round down, so a token which expires later today has 0 days left and
+	// one which expired earlier today has -1.
+	return int(math.Floor(time.Until(token.ValidTo.Time).Hours() / 24)), nil
+}
@@ -0,0 +1,258 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// The check configuration model in the SDK does not include the settings, so
+// they are unmarshalled alongside it.
+type pipelineCheckConfiguration struct {
+	pipelineschecks.CheckConfiguration
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// Most checks are generic "Task Check" configurations that run a server task,
+// so the task name is used to tell them apart.
+var pipelineCheckTaskTypes = map[string]string{
+	"azurefunction":            "invoke_azure_function",
+	"azuremonitor":             "query_azure_monitor",
+	"businesshours":            "business_hours",
+	"evaluatebranchprotection": "branch_control",
+	"invokerestapi":            "invoke_rest_api",
+}
+
+var pipelineCheckTypes = map[string]string{
+	"approval":      "approval",
+	"exclusivelock": "exclusive_lock",
+	"extendscheck":  "required_template",
+}
+
+func tableAzureDevOpsPipelineCheck(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_check",
+		Description: "Represents an approval or check configured on an Azure DevOps protected resource.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listPipelineChecks,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "resource_type", Require: plugin.Required},
+				{Name: "resource_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "links",
+				Description: "Reference links.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Links"),
+			},
+			{
+				Name:        "approvers",
+				Description: "The display names of the approvers configured on an approval check.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(pipelineCheckApprovers),
+			},
+			{
+				Name:        "check_type",
+				Description: "The kind of check: approval, business_hours, invoke_rest_api, invoke_azure_function, query_azure_monitor, branch_control, required_template or exclusive_lock.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(pipelineCheckType),
+			},
+			{
+				Name:        "created_by",
+				Description: "Identity of person who configured the check.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_on",
+				Description: "Time when the check was configured.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "id",
+				Description: "Check configuration ID.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "min_required_approvers",
+				Description: "The minimum number of approvers required on an approval check.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Settings.minRequiredApprovers"),
+			},
+			{
+				Name:        "modified_by",
+				Description: "Identity of person who modified the configured check.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ModifiedBy"),
+			},
+			{
+				Name:        "modified_on",
+				Description: "Time when the configured check was modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedOn.Time"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the check belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "ID of the resource on which the check is configured.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Id"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "Name of the resource on which the check is configured.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Name"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "Type of the resource on which the check is configured.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Type"),
+			},
+			{
+				Name:        "settings",
+				Description: "The settings of the check.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Settings"),
+			},
+			{
+				Name:        "timeout",
+				Description: "Timeout in minutes for the check.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Timeout"),
+			},
+			{
+				Name:        "type_id",
+				Description: "Check type ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.Id"),
+			},
+			{
+				Name:        "type_name",
+				Description: "Name of the check type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type.Name"),
+			},
+			{
+				Name:        "url",
+				Description: "The URL from which one can fetch the configured check.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+		},
+	}
+}
+
+func listPipelineChecks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	resourceType := d.KeyColumnQuals["resource_type"].GetStringValue()
+	resourceID := d.KeyColumnQuals["resource_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_pipeline_check.listPipelineChecks", "connection_error", err)
+		return nil, err
+	}
+
+	client := connection.GetClientByUrl(connection.BaseUrl)
+
+	routeValues := make(map[string]string)
+	routeValues["project"] = projectID
+
+	queryParams := url.Values{}
+	queryParams.Set("$expand", string(pipelineschecks.CheckConfigurationExpandParameterValues.Settings))
+	queryParams.Set("resourceType", resourceType)
+	queryParams.Set("resourceId", resourceID)
+
+	locationId, _ := uuid.Parse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+
+	response, err := client.Send(ctx, http.MethodGet, locationId, "6.0-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		logger.Error("listPipelineChecks", "list_pipeline_checks_error", err)
+		return nil, err
+	}
+
+	var checks []pipelineCheckConfiguration
+	err = client.UnmarshalCollectionBody(response, &checks)
+	if err != nil {
+		logger.Error("listPipelineChecks", "unmarshal_error", err)
+		return nil, err
+	}
+
+	for _, check := range checks {
+		d.StreamListItem(ctx, check)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func pipelineCheckType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	check := d.HydrateItem.(pipelineCheckConfiguration)
+	if check.Type == nil || check.Type.Name == nil {
+		return nil, nil
+	}
+
+	typeName := strings.ToLower(strings.ReplaceAll(*check.Type.Name, " ", ""))
+	if checkType, ok := pipelineCheckTypes[typeName]; ok {
+		return checkType, nil
+	}
+
+	if typeName == "taskcheck" {
+		definitionRef, _ := check.Settings["definitionRef"].(map[string]interface{})
+		taskName, _ := definitionRef["name"].(string)
+		taskName = strings.ToLower(taskName)
+
+		if checkType, ok := pipelineCheckTaskTypes[taskName]; ok {
+			return checkType, nil
+		}
+		if taskName != "" {
+			return taskName, nil
+		}
+	}
+
+	return typeName, nil
+}
+
+func pipelineCheckApprovers(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	check := d.HydrateItem.(pipelineCheckConfiguration)
+
+	approvers, ok := check.Settings["approvers"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	names := []string{}
+	for _, approver := range approvers {
+		identity, _ := approver.(map[string]interface{})
+		if name, ok := identity["displayName"].(string); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
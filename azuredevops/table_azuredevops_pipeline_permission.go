@@ -0,0 +1,181 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinepermissions"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// pipelinePermission is a pipeline authorized to use a protected resource,
+// alongside the permissions of the resource itself.
+type pipelinePermission struct {
+	pipelinepermissions.ResourcePipelinePermissions
+	Pipeline *pipelinepermissions.PipelinePermission
+}
+
+func tableAzureDevOpsPipelinePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_pipeline_permission",
+		Description: "Represents a pipeline authorized to use an Azure DevOps protected resource.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listPipelinePermissions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "resource_type", Require: plugin.Required},
+				{Name: "resource_id", Require: plugin.Required},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "all_pipelines_authorized",
+				Description: "Indicates whether every pipeline in the project is authorized to use the resource.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AllPipelines.Authorized"),
+				Default:     false,
+			},
+			{
+				Name:        "all_pipelines_authorized_by",
+				Description: "The identity that authorized all pipelines to use the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AllPipelines.AuthorizedBy"),
+			},
+			{
+				Name:        "all_pipelines_authorized_on",
+				Description: "The time all pipelines were authorized to use the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AllPipelines.AuthorizedOn.Time"),
+			},
+			{
+				Name:        "authorized_pipeline_ids",
+				Description: "The IDs of the pipelines that are individually authorized to use the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(pipelinePermissionAuthorizedPipelineIds),
+			},
+			{
+				Name:        "pipeline_authorized_by",
+				Description: "The identity that authorized the pipeline to use the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Pipeline.AuthorizedBy"),
+			},
+			{
+				Name:        "pipeline_authorized_on",
+				Description: "The time the pipeline was authorized to use the resource.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Pipeline.AuthorizedOn.Time"),
+			},
+			{
+				Name:        "pipeline_id",
+				Description: "ID of the pipeline that is individually authorized to use the resource. Null if no pipeline is individually authorized.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Pipeline.Id"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "ID of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_id"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "Name of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource.Name"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "Type of the resource, e.g. endpoint, queue, variablegroup, securefile, environment or repository.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_type"),
+			},
+		},
+	}
+}
+
+func listPipelinePermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	resourceType := d.KeyColumnQuals["resource_type"].GetStringValue()
+	resourceID := d.KeyColumnQuals["resource_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_pipeline_permission.listPipelinePermissions", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := pipelinepermissions.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_pipeline_permission.listPipelinePermissions", "client_error", err)
+		return nil, err
+	}
+
+	input := pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+		Project:      &projectID,
+		ResourceType: &resourceType,
+		ResourceId:   &resourceID,
+	}
+
+	response, err := client.GetPipelinePermissionsForResource(ctx, input)
+	if err != nil {
+		logger.Error("listPipelinePermissions", "get_pipeline_permissions_error", err)
+		return nil, err
+	}
+
+	// Resources without individually authorized pipelines, e.g. those open to
+	// all pipelines, still return exactly one row with a null pipeline_id.
+	var pipelines []pipelinepermissions.PipelinePermission
+	if response.Pipelines != nil {
+		for _, pipeline := range *response.Pipelines {
+			if pipeline.Id != nil && pipeline.Authorized != nil && *pipeline.Authorized {
+				pipelines = append(pipelines, pipeline)
+			}
+		}
+	}
+
+	if len(pipelines) == 0 {
+		d.StreamListItem(ctx, pipelinePermission{ResourcePipelinePermissions: *response})
+		return nil, nil
+	}
+
+	for _, pipeline := range pipelines {
+		pipeline := pipeline
+		d.StreamListItem(ctx, pipelinePermission{
+			ResourcePipelinePermissions: *response,
+			Pipeline:                    &pipeline,
+		})
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func pipelinePermissionAuthorizedPipelineIds(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	permission := d.HydrateItem.(pipelinePermission)
+
+	ids := []int{}
+	if permission.Pipelines == nil {
+		return ids, nil
+	}
+
+	for _, pipeline := range *permission.Pipelines {
+		if pipeline.Id != nil && pipeline.Authorized != nil && *pipeline.Authorized {
+			ids = append(ids, *pipeline.Id)
+		}
+	}
+
+	return ids, nil
+}
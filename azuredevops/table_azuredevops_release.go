@@ -0,0 +1,331 @@
+package azuredevops
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsRelease(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_release",
+		Description: "Represents an Azure DevOps classic release.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listReleases,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "created_by_id", Require: plugin.Optional},
+				{Name: "created_on", Operators: []string{">", ">=", "<", "<=", "="}, Require: plugin.Optional},
+				{Name: "definition_id", Require: plugin.Optional},
+				{Name: "source_branch_filter", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "links",
+				Description: "The links to access the release.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Links"),
+			},
+			{
+				Name:        "artifacts",
+				Description: "The list of artifacts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Artifacts"),
+			},
+			{
+				Name:        "comment",
+				Description: "The comment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment"),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity who created the release.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_by_display_name",
+				Description: "The display name of the identity who created the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.DisplayName"),
+			},
+			{
+				Name:        "created_by_id",
+				Description: "The ID of the identity who created the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.Id"),
+			},
+			{
+				Name:        "created_by_unique_name",
+				Description: "The unique name of the identity who created the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.UniqueName"),
+			},
+			{
+				Name:        "created_for",
+				Description: "The identity for whom the release was created.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedFor"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The date on which the release was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "definition_id",
+				Description: "The ID of the release definition this release is associated with.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ReleaseDefinition.Id"),
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the release definition this release is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReleaseDefinition.Name"),
+			},
+			{
+				Name:        "definition_snapshot_revision",
+				Description: "The revision number of the definition snapshot.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DefinitionSnapshotRevision"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "environments",
+				Description: "The list of environments.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Environments"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the release.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "keep_forever",
+				Description: "Whether to exclude the release from retention policies.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("KeepForever"),
+			},
+			{
+				Name:        "logs_container_url",
+				Description: "The logs container url.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LogsContainerUrl"),
+			},
+			{
+				Name:        "modified_by",
+				Description: "The identity who last modified the release.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ModifiedBy"),
+			},
+			{
+				Name:        "modified_on",
+				Description: "The date on which the release was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedOn.Time"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "pool_name",
+				Description: "The pool name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolName"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this release belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "properties",
+				Description: "The properties of the release.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties"),
+			},
+			{
+				Name:        "reason",
+				Description: "The reason of the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reason"),
+			},
+			{
+				Name:        "release_definition",
+				Description: "The reference of the release definition this release is associated with.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ReleaseDefinition"),
+			},
+			{
+				Name:        "release_definition_revision",
+				Description: "The release definition revision.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ReleaseDefinitionRevision"),
+			},
+			{
+				Name:        "release_name_format",
+				Description: "The release name format.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReleaseNameFormat"),
+			},
+			{
+				Name:        "source_branch_filter",
+				Description: "Only return releases of artifacts built from this source branch.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("source_branch_filter"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "tags",
+				Description: "The list of tags.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "triggering_artifact_alias",
+				Description: "The alias of the artifact that triggered the release.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TriggeringArtifactAlias"),
+			},
+			{
+				Name:        "variable_groups",
+				Description: "The list of variable groups.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VariableGroups"),
+			},
+			{
+				Name:        "variables",
+				Description: "The dictionary of variables.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Variables"),
+			},
+		},
+	}
+}
+
+func listReleases(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_release.listReleases", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := release.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_release.listReleases", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	input := release.GetReleasesArgs{
+		Project: &projectID,
+		Top:     &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	// Nested collections are only returned when expanded, so only ask for the ones being selected.
+	var expands []string
+	for _, column := range []string{"artifacts", "environments", "tags", "variables"} {
+		if isColumnRequested(d, column) {
+			expands = append(expands, column)
+		}
+	}
+	if len(expands) > 0 {
+		expand := release.ReleaseExpands(strings.Join(expands, ","))
+		input.Expand = &expand
+	}
+
+	if value, ok := d.KeyColumnQuals["created_by_id"]; ok {
+		createdBy := value.GetStringValue()
+		if createdBy != "" {
+			input.CreatedBy = &createdBy
+		}
+	}
+
+	input.MinCreatedTime, input.MaxCreatedTime = getQualTimeRange(d, "created_on")
+
+	if value, ok := d.KeyColumnQuals["definition_id"]; ok && value.GetListValue() == nil {
+		definitionID := int(value.GetInt64Value())
+		input.DefinitionId = &definitionID
+	}
+
+	if value, ok := d.KeyColumnQuals["source_branch_filter"]; ok {
+		sourceBranch := value.GetStringValue()
+		input.SourceBranchFilter = &sourceBranch
+	}
+
+	if value, ok := d.KeyColumnQuals["status"]; ok {
+		status := release.ReleaseStatus(value.GetStringValue())
+		if status != "" {
+			input.StatusFilter = &status
+		}
+	}
+
+	for {
+		response, err := client.GetReleases(ctx, input)
+		if err != nil {
+			logger.Error("listReleases", "list_releases_error", err)
+			return nil, err
+		}
+
+		for _, rel := range (*response).Value {
+			d.StreamListItem(ctx, rel)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if response.ContinuationToken == "" {
+			break
+		}
+
+		// Unlike most other APIs, releases take a numeric continuation token.
+		continuationToken, err := strconv.Atoi(response.ContinuationToken)
+		if err != nil {
+			logger.Error("listReleases", "continuation_token_error", err)
+			return nil, err
+		}
+
+		input.ContinuationToken = &continuationToken
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,256 @@
+package azuredevops
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsReleaseDefinition(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_release_definition",
+		Description: "Represents an Azure DevOps classic release definition.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listReleaseDefinitions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "id", Require: plugin.Optional},
+				{Name: "path", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "links",
+				Description: "The links to related resources, APIs, and views for the release definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Links"),
+			},
+			{
+				Name:        "artifacts",
+				Description: "The list of artifacts.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Artifacts"),
+			},
+			{
+				Name:        "comment",
+				Description: "The comment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Comment"),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity who created the release definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_by_display_name",
+				Description: "The display name of the identity who created the release definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CreatedBy.DisplayName"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The date on which the release definition was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The description.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "environments",
+				Description: "The list of environments.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Environments"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the release definition.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "is_deleted",
+				Description: "Whether the release definition is deleted.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsDeleted"),
+			},
+			{
+				Name:        "last_release",
+				Description: "The reference of the last release.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LastRelease"),
+			},
+			{
+				Name:        "modified_by",
+				Description: "The identity who last modified the release definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ModifiedBy"),
+			},
+			{
+				Name:        "modified_by_display_name",
+				Description: "The display name of the identity who last modified the release definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ModifiedBy.DisplayName"),
+			},
+			{
+				Name:        "modified_on",
+				Description: "The date on which the release definition was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedOn.Time"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the release definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "path",
+				Description: "The path of the release definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Path"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this release definition belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "properties",
+				Description: "The properties of the release definition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties"),
+			},
+			{
+				Name:        "release_name_format",
+				Description: "The release name format.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReleaseNameFormat"),
+			},
+			{
+				Name:        "revision",
+				Description: "The revision number.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Revision"),
+			},
+			{
+				Name:        "source",
+				Description: "The source of the release definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Source"),
+			},
+			{
+				Name:        "tags",
+				Description: "The list of tags.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "triggers",
+				Description: "The list of triggers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Triggers"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST API url to access the release definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+			{
+				Name:        "variable_groups",
+				Description: "The list of variable group IDs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VariableGroups"),
+			},
+			{
+				Name:        "variables",
+				Description: "The dictionary of variables.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Variables"),
+			},
+		},
+	}
+}
+
+func listReleaseDefinitions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_release_definition.listReleaseDefinitions", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := release.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_release_definition.listReleaseDefinitions", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	expand := release.ReleaseDefinitionExpands("environments,artifacts,triggers")
+	input := release.GetReleaseDefinitionsArgs{
+		Project: &projectID,
+		Expand:  &expand,
+		Top:     &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["id"]; ok {
+		var ids []string
+		for _, id := range getQualIntList(value) {
+			ids = append(ids, strconv.Itoa(id))
+		}
+		input.DefinitionIdFilter = &ids
+	}
+
+	if value, ok := d.KeyColumnQuals["path"]; ok {
+		path := value.GetStringValue()
+		if path != "" {
+			input.Path = &path
+		}
+	}
+
+	for {
+		response, err := client.GetReleaseDefinitions(ctx, input)
+		if err != nil {
+			logger.Error("listReleaseDefinitions", "list_release_definitions_error", err)
+			return nil, err
+		}
+
+		for _, definition := range (*response).Value {
+			d.StreamListItem(ctx, definition)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		input.ContinuationToken = &response.ContinuationToken
+		if *input.ContinuationToken == "" {
+			break
+		}
+	}
+
+	return nil, nil
+}
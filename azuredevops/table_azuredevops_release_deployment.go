@@ -0,0 +1,332 @@
+package azuredevops
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsReleaseDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_release_deployment",
+		Description: "Represents a deployment of an Azure DevOps classic release to a stage.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listReleaseDeployments,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "definition_environment_id", Require: plugin.Optional},
+				{Name: "definition_id", Require: plugin.Optional},
+				{Name: "deployment_status", Require: plugin.Optional},
+				{Name: "last_modified_on", Operators: []string{">", ">=", "<", "<=", "="}, Require: plugin.Optional},
+				{Name: "operation_status", Require: plugin.Optional},
+				{Name: "requested_by_id", Require: plugin.Optional},
+				{Name: "requested_for_id", Require: plugin.Optional},
+				{Name: "started_on", Operators: []string{">", ">=", "<", "<=", "="}, Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "approved_by",
+				Description: "The unique names of the identities who approved the pre-deployment and post-deployment approvals.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(releaseDeploymentApprovedBy),
+			},
+			{
+				Name:        "attempt",
+				Description: "The attempt number.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Attempt"),
+			},
+			{
+				Name:        "completed_on",
+				Description: "The date on which the deployment completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CompletedOn.Time"),
+			},
+			{
+				Name:        "conditions",
+				Description: "The list of conditions associated with the deployment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Conditions"),
+			},
+			{
+				Name:        "definition_environment_id",
+				Description: "The ID of the release definition environment (stage).",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DefinitionEnvironmentId"),
+			},
+			{
+				Name:        "definition_id",
+				Description: "The ID of the release definition the deployment is associated with.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ReleaseDefinition.Id"),
+			},
+			{
+				Name:        "definition_name",
+				Description: "The name of the release definition the deployment is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReleaseDefinition.Name"),
+			},
+			{
+				Name:        "deployment_status",
+				Description: "The status of the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DeploymentStatus"),
+			},
+			{
+				Name:        "environment_id",
+				Description: "The ID of the release environment the deployment is associated with.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ReleaseEnvironment.Id"),
+			},
+			{
+				Name:        "environment_name",
+				Description: "The name of the release environment the deployment is associated with.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ReleaseEnvironment.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier of the deployment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "last_modified_by",
+				Description: "The identity who last modified the deployment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LastModifiedBy"),
+			},
+			{
+				Name:        "last_modified_on",
+				Description: "The date on which the deployment was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastModifiedOn.Time"),
+			},
+			{
+				Name:        "operation_status",
+				Description: "The operation status of the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OperationStatus"),
+			},
+			{
+				Name:        "post_deploy_approvals",
+				Description: "The list of post-deployment approvals.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PostDeployApprovals"),
+			},
+			{
+				Name:        "pre_deploy_approvals",
+				Description: "The list of pre-deployment approvals.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PreDeployApprovals"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project this deployment belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "queued_on",
+				Description: "The date on which the deployment was queued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("QueuedOn.Time"),
+			},
+			{
+				Name:        "reason",
+				Description: "The reason of the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Reason"),
+			},
+			{
+				Name:        "release_id",
+				Description: "The ID of the release that was deployed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Release.Id"),
+			},
+			{
+				Name:        "release_name",
+				Description: "The name of the release that was deployed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Release.Name"),
+			},
+			{
+				Name:        "requested_by",
+				Description: "The identity who requested the deployment.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RequestedBy"),
+			},
+			{
+				Name:        "requested_by_id",
+				Description: "The ID of the identity who requested the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedBy.Id"),
+			},
+			{
+				Name:        "requested_for",
+				Description: "The identity for whom the deployment was requested.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("RequestedFor"),
+			},
+			{
+				Name:        "requested_for_id",
+				Description: "The ID of the identity for whom the deployment was requested.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RequestedFor.Id"),
+			},
+			{
+				Name:        "scheduled_deployment_time",
+				Description: "The date on which the deployment is scheduled.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduledDeploymentTime.Time"),
+			},
+			{
+				Name:        "started_on",
+				Description: "The date on which the deployment started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("StartedOn.Time"),
+			},
+		},
+	}
+}
+
+func listReleaseDeployments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_release_deployment.listReleaseDeployments", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := release.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_release_deployment.listReleaseDeployments", "client_error", err)
+		return nil, err
+	}
+
+	top := 999
+	input := release.GetDeploymentsArgs{
+		Project: &projectID,
+		Top:     &top,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top = int(*limit)
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["definition_environment_id"]; ok && value.GetListValue() == nil {
+		definitionEnvironmentID := int(value.GetInt64Value())
+		input.DefinitionEnvironmentId = &definitionEnvironmentID
+	}
+
+	if value, ok := d.KeyColumnQuals["definition_id"]; ok && value.GetListValue() == nil {
+		definitionID := int(value.GetInt64Value())
+		input.DefinitionId = &definitionID
+	}
+
+	if value, ok := d.KeyColumnQuals["deployment_status"]; ok {
+		status := release.DeploymentStatus(value.GetStringValue())
+		if status != "" {
+			input.DeploymentStatus = &status
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["operation_status"]; ok {
+		status := release.DeploymentOperationStatus(value.GetStringValue())
+		if status != "" {
+			input.OperationStatus = &status
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["requested_by_id"]; ok {
+		createdBy := value.GetStringValue()
+		if createdBy != "" {
+			input.CreatedBy = &createdBy
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["requested_for_id"]; ok {
+		createdFor := value.GetStringValue()
+		if createdFor != "" {
+			input.CreatedFor = &createdFor
+		}
+	}
+
+	input.MinModifiedTime, input.MaxModifiedTime = getQualTimeRange(d, "last_modified_on")
+	input.MinStartedTime, input.MaxStartedTime = getQualTimeRange(d, "started_on")
+
+	for {
+		response, err := client.GetDeployments(ctx, input)
+		if err != nil {
+			logger.Error("listReleaseDeployments", "list_release_deployments_error", err)
+			return nil, err
+		}
+
+		for _, deployment := range (*response).Value {
+			d.StreamListItem(ctx, deployment)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if response.ContinuationToken == "" {
+			break
+		}
+
+		continuationToken, err := strconv.Atoi(response.ContinuationToken)
+		if err != nil {
+			logger.Error("listReleaseDeployments", "continuation_token_error", err)
+			return nil, err
+		}
+
+		input.ContinuationToken = &continuationToken
+	}
+
+	return nil, nil
+}
+
+func releaseDeploymentApprovedBy(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	deployment := d.HydrateItem.(release.Deployment)
+
+	var approvals []release.ReleaseApproval
+	if deployment.PreDeployApprovals != nil {
+		approvals = append(approvals, *deployment.PreDeployApprovals...)
+	}
+	if deployment.PostDeployApprovals != nil {
+		approvals = append(approvals, *deployment.PostDeployApprovals...)
+	}
+
+	seen := make(map[string]bool)
+	approvedBy := []string{}
+	for _, approval := range approvals {
+		if approval.Status == nil || *approval.Status != release.ApprovalStatusValues.Approved || approval.ApprovedBy == nil {
+			continue
+		}
+
+		name := approval.ApprovedBy.UniqueName
+		if name == nil {
+			name = approval.ApprovedBy.DisplayName
+		}
+		if name == nil || seen[*name] {
+			continue
+		}
+
+		seen[*name] = true
+		approvedBy = append(approvedBy, *name)
+	}
+
+	return approvedBy, nil
+}
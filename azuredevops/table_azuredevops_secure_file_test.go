@@ -0,0 +1,74 @@
+package azuredevops
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func TestSecureFileExpirationDate(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]string
+		want       interface{}
+	}{
+		{"no properties", nil, nil},
+		{"no expiration property", map[string]string{"Subject": "CN=Contoso"}, nil},
+		{"rfc3339", map[string]string{"ExpirationDate": "2026-03-04T10:00:00Z"}, time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{"date only", map[string]string{"NotAfter": "2026-03-04"}, time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"suffix match ignores case", map[string]string{"Certificate.NotAfter": "2026-03-04 10:00:00"}, time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{
+			"expirationdate before validto",
+			map[string]string{"ValidTo": "2027-01-01", "ExpirationDate": "2026-01-01"},
+			time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"expiration_date before expirydate",
+			map[string]string{"ExpiryDate": "2027-01-01", "expiration_date": "2026-01-01"},
+			time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"expirydate before notafter",
+			map[string]string{"NotAfter": "2027-01-01", "ExpiryDate": "2026-01-01"},
+			time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"notafter before validto",
+			map[string]string{"ValidTo": "2027-01-01", "NotAfter": "2026-01-01"},
+			time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"same suffix picks keys in sorted order",
+			map[string]string{"b.NotAfter": "2027-01-01", "a.NotAfter": "2026-01-01"},
+			time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"unparseable property falls back to the next one",
+			map[string]string{"ExpirationDate": "soon", "ValidTo": "2027-01-01"},
+			time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{"unparseable property", map[string]string{"ExpirationDate": "soon"}, nil},
+		{"ambiguous slash date", map[string]string{"ExpirationDate": "03/04/2026 10:00:00"}, nil},
+		{"empty value", map[string]string{"ExpirationDate": ""}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := taskagent.SecureFile{}
+			if tt.properties != nil {
+				file.Properties = &tt.properties
+			}
+
+			got, err := secureFileExpirationDate(context.Background(), &transform.TransformData{HydrateItem: file})
+			if err != nil {
+				t.Fatalf("secureFileExpirationDate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("secureFileExpirationDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsSecurityNamespace(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_security_namespace",
+		Description: "Represents an Azure DevOps security namespace, which defines the permissions which can be granted on a type of resource.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listSecurityNamespaces,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "namespace_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "actions",
+				Description: "The permissions (actions) which can be granted in the namespace, with their bits.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Actions"),
+			},
+			{
+				Name:        "dataspace_category",
+				Description: "The dataspace category of the namespace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DataspaceCategory"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the namespace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "element_length",
+				Description: "The length of each element of a token, if the tokens are hierarchical and fixed length.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ElementLength"),
+			},
+			{
+				Name:        "extension_type",
+				Description: "The type of the extension which provides the namespace, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ExtensionType"),
+			},
+			{
+				Name:        "is_remotable",
+				Description: "Whether the namespace is remotable.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsRemotable"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the namespace, e.g. Git Repositories.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "namespace_id",
+				Description: "The ID of the namespace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NamespaceId"),
+			},
+			{
+				Name:        "read_permission",
+				Description: "The bit of the permission required to read the ACLs of the namespace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ReadPermission"),
+			},
+			{
+				Name:        "separator_value",
+				Description: "The separator between the elements of a hierarchical token, e.g. /.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SeparatorValue"),
+			},
+			{
+				Name:        "structure_value",
+				Description: "The structure of the namespace, 1 for flat or 2 for hierarchical.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("StructureValue"),
+			},
+			{
+				Name:        "system_bit_mask",
+				Description: "The mask of the bits reserved for the system.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SystemBitMask"),
+			},
+			{
+				Name:        "use_token_translator",
+				Description: "Whether the namespace uses a token translator.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("UseTokenTranslator"),
+			},
+			{
+				Name:        "write_permission",
+				Description: "The bit of the permission required to write the ACLs of the namespace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("WritePermission"),
+			},
+		},
+	}
+}
+
+func listSecurityNamespaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_security_namespace.listSecurityNamespaces", "connection_error", err)
+		return nil, err
+	}
+
+	client := security.NewClient(ctx, connection)
+
+	input := security.QuerySecurityNamespacesArgs{}
+
+	if value, ok := d.KeyColumnQuals["namespace_id"]; ok {
+		namespaceID, err := uuid.Parse(value.GetStringValue())
+		if err != nil {
+			logger.Error("listSecurityNamespaces", "parse_namespace_id_error", err)
+			return nil, err
+		}
+		input.SecurityNamespaceId = &namespaceID
+	}
+
+	response, err := client.QuerySecurityNamespaces(ctx, input)
+	if err != nil {
+		logger.Error("listSecurityNamespaces", "query_security_namespaces_error", err)
+		return nil, err
+	}
+
+	for _, namespace := range *response {
+		d.StreamListItem(ctx, namespace)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
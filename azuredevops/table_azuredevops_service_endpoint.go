@@ -0,0 +1,361 @@
+package azuredevops
+
+import (
+	"context"
+	"strings"
+
+	ado "github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// serviceEndpointExecutionHistorySize is the number of execution records
+// fetched for each service endpoint. Records are returned newest first, so
+// this is enough to tell when a service endpoint was last used.
+const serviceEndpointExecutionHistorySize = 50
+
+func tableAzureDevOpsServiceEndpoint(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_service_endpoint",
+		Description: "Represents an Azure DevOps service endpoint (service connection).",
+
+		List: &plugin.ListConfig{
+			Hydrate: listServiceEndpoints,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "authorization_scheme", Require: plugin.Optional},
+				{Name: "type", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "administrators_group",
+				Description: "The identity reference for the administrators group of the service endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AdministratorsGroup"),
+			},
+			{
+				Name:        "authorization_scheme",
+				Description: "The authorization scheme of the service endpoint, e.g. ServicePrincipal or WorkloadIdentityFederation. Credentials are never returned.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Authorization.Scheme"),
+			},
+			{
+				Name:        "azure_management_group_id",
+				Description: "The Azure management group the service endpoint is scoped to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(serviceEndpointData, "managementGroupId"),
+			},
+			{
+				Name:        "azure_scope",
+				Description: "The Azure scope (subscription, resource group or resource) the service principal is granted access to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(serviceEndpointAuthorizationParameter, "scope"),
+			},
+			{
+				Name:        "azure_scope_level",
+				Description: "The level at which the Azure Resource Manager service endpoint is scoped, e.g. Subscription or ManagementGroup.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(serviceEndpointData, "scopeLevel"),
+			},
+			{
+				Name:        "azure_subscription_id",
+				Description: "The Azure subscription ID of an Azure Resource Manager service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(serviceEndpointData, "subscriptionId"),
+			},
+			{
+				Name:        "azure_subscription_name",
+				Description: "The Azure subscription name of an Azure Resource Manager service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(serviceEndpointData, "subscriptionName"),
+			},
+			{
+				Name:        "azure_tenant_id",
+				Description: "The Azure Active Directory tenant of an Azure Resource Manager service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(serviceEndpointAuthorizationParameter, "tenantid"),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity who created the service endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "data",
+				Description: "The service endpoint data.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "execution_history",
+				Description: "The most recent executions (pipeline jobs) which used the service endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getServiceEndpointExecutionHistory,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "is_ready",
+				Description: "Indicates whether the service endpoint is ready to be used.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsReady"),
+			},
+			{
+				Name:        "is_shared",
+				Description: "Indicates whether the service endpoint is shared with other projects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsShared"),
+			},
+			{
+				Name:        "last_used_on",
+				Description: "The date the service endpoint was last used by a pipeline, based on its most recent executions.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getServiceEndpointExecutionHistory,
+				Transform:   transform.From(serviceEndpointLastUsedOn),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "operation_status",
+				Description: "The error or warning of the service endpoint, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OperationStatus"),
+			},
+			{
+				Name:        "owner",
+				Description: "The owner of the service endpoint, e.g. Library or AgentCloud.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Owner"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the service endpoint is queried from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "project_references",
+				Description: "The project references where the service endpoint is shared.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ServiceEndpointProjectReferences"),
+			},
+			{
+				Name:        "readers_group",
+				Description: "The identity reference for the readers group of the service endpoint.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ReadersGroup"),
+			},
+			{
+				Name:        "shared_project_names",
+				Description: "The names of the projects the service endpoint is shared with.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(serviceEndpointSharedProjectNames),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the service endpoint, e.g. azurerm or github.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type"),
+			},
+			{
+				Name:        "url",
+				Description: "The URL of the service endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+		},
+	}
+}
+
+func listServiceEndpoints(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_service_endpoint.listServiceEndpoints", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := serviceendpoint.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_service_endpoint.listServiceEndpoints", "client_error", err)
+		return nil, err
+	}
+
+	input := serviceendpoint.GetServiceEndpointsArgs{
+		Project: &projectID,
+	}
+
+	if value, ok := d.KeyColumnQuals["authorization_scheme"]; ok {
+		var schemes []string
+		if list := value.GetListValue(); list != nil {
+			for _, item := range list.Values {
+				schemes = append(schemes, item.GetStringValue())
+			}
+		} else if scheme := value.GetStringValue(); scheme != "" {
+			schemes = append(schemes, scheme)
+		}
+		if len(schemes) > 0 {
+			input.AuthSchemes = &schemes
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["type"]; ok {
+		endpointType := value.GetStringValue()
+		if endpointType != "" {
+			input.Type = &endpointType
+		}
+	}
+
+	response, err := client.GetServiceEndpoints(ctx, input)
+	if err != nil {
+		logger.Error("listServiceEndpoints", "list_service_endpoints_error", err)
+		return nil, err
+	}
+
+	for _, endpoint := range *response {
+		d.StreamListItem(ctx, endpoint)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getServiceEndpointExecutionHistory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+	endpoint := h.Item.(serviceendpoint.ServiceEndpoint)
+
+	if endpoint.Id == nil {
+		return nil, nil
+	}
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_service_endpoint.getServiceEndpointExecutionHistory", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := serviceendpoint.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_service_endpoint.getServiceEndpointExecutionHistory", "client_error", err)
+		return nil, err
+	}
+
+	top := serviceEndpointExecutionHistorySize
+	input := serviceendpoint.GetServiceEndpointExecutionRecordsArgs{
+		Project:    &projectID,
+		EndpointId: endpoint.Id,
+		Top:        &top,
+	}
+
+	response, err := client.GetServiceEndpointExecutionRecords(ctx, input)
+	if err != nil {
+		logger.Error("getServiceEndpointExecutionHistory", "get_service_endpoint_execution_records_error", err)
+		return nil, err
+	}
+
+	var history []serviceendpoint.ServiceEndpointExecutionData
+	for _, record := range response.Value {
+		if record.Data != nil {
+			history = append(history, *record.Data)
+		}
+	}
+
+	return history, nil
+}
+
+func serviceEndpointLastUsedOn(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	history, ok := d.HydrateItem.([]serviceendpoint.ServiceEndpointExecutionData)
+	if !ok {
+		return nil, nil
+	}
+
+	var lastUsedOn *ado.Time
+	for _, execution := range history {
+		usedOn := execution.FinishTime
+		if usedOn == nil {
+			usedOn = execution.StartTime
+		}
+
+		if usedOn != nil && (lastUsedOn == nil || usedOn.Time.After(lastUsedOn.Time)) {
+			lastUsedOn = usedOn
+		}
+	}
+
+	if lastUsedOn == nil {
+		return nil, nil
+	}
+
+	return lastUsedOn.Time, nil
+}
+
+func serviceEndpointData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	endpoint := d.HydrateItem.(serviceendpoint.ServiceEndpoint)
+	if endpoint.Data == nil {
+		return nil, nil
+	}
+
+	if value, ok := (*endpoint.Data)[d.Param.(string)]; ok && value != "" {
+		return value, nil
+	}
+
+	return nil, nil
+}
+
+func serviceEndpointAuthorizationParameter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	endpoint := d.HydrateItem.(serviceendpoint.ServiceEndpoint)
+	if endpoint.Authorization == nil || endpoint.Authorization.Parameters == nil {
+		return nil, nil
+	}
+
+	// Only return the requested parameter, the others may contain secrets.
+	for key, value := range *endpoint.Authorization.Parameters {
+		if strings.EqualFold(key, d.Param.(string)) && value != "" {
+			return value, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func serviceEndpointSharedProjectNames(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	endpoint := d.HydrateItem.(serviceendpoint.ServiceEndpoint)
+
+	names := []string{}
+	if endpoint.ServiceEndpointProjectReferences == nil {
+		return names, nil
+	}
+
+	for _, reference := range *endpoint.ServiceEndpointProjectReferences {
+		if reference.ProjectReference != nil && reference.ProjectReference.Name != nil {
+			names = append(names, *reference.ProjectReference.Name)
+		}
+	}
+
+	return names, nil
+}
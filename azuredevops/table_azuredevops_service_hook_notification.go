@@ -0,0 +1,216 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/servicehooks"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsServiceHookNotification(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_service_hook_notification",
+		Description: "Represents a recent notification (delivery attempt) of an Azure DevOps service hook subscription.",
+
+		List: &plugin.ListConfig{
+			ParentHydrate: listServiceHookSubscriptions,
+			Hydrate:       listServiceHookNotifications,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "subscription_id", Require: plugin.Optional},
+				{Name: "result", Require: plugin.Optional},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "completed_date",
+				Description: "The date the notification was completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Details.CompletedDate.Time"),
+			},
+			{
+				Name:        "consumer_action_id",
+				Description: "The ID of the consumer action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details.ConsumerActionId"),
+			},
+			{
+				Name:        "consumer_id",
+				Description: "The ID of the consumer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details.ConsumerId"),
+			},
+			{
+				Name:        "consumer_inputs",
+				Description: "The consumer inputs used for the delivery. Inputs which look like credentials, and the credentials in URL inputs, are masked.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(serviceHookNotificationConsumerInputs),
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the notification was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedDate.Time"),
+			},
+			{
+				Name:        "error_detail",
+				Description: "The details of the delivery error, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details.ErrorDetail"),
+			},
+			{
+				Name:        "error_message",
+				Description: "The message of the delivery error, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details.ErrorMessage"),
+			},
+			{
+				Name:        "event_id",
+				Description: "The ID of the event which triggered the notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventId"),
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of event which triggered the notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details.EventType"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the notification.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "modified_date",
+				Description: "The date the notification was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedDate.Time"),
+			},
+			{
+				Name:        "publisher_id",
+				Description: "The ID of the publisher.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Details.PublisherId"),
+			},
+			{
+				Name:        "queued_date",
+				Description: "The date the notification was queued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Details.QueuedDate.Time"),
+			},
+			{
+				Name:        "request_attempts",
+				Description: "The number of delivery attempts.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Details.RequestAttempts"),
+			},
+			{
+				Name:        "request_duration",
+				Description: "The duration of the delivery request.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("Details.RequestDuration"),
+			},
+			{
+				Name:        "result",
+				Description: "The result of the notification, e.g. pending, succeeded, failed or filtered.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Result"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the notification, e.g. queued, processing or completed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "subscriber_id",
+				Description: "The ID of the subscriber.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriberId"),
+			},
+			{
+				Name:        "subscription_id",
+				Description: "The ID of the subscription which produced the notification.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionId"),
+			},
+		},
+	}
+}
+
+func listServiceHookNotifications(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	subscription := h.Item.(servicehooks.Subscription)
+
+	if subscription.Id == nil {
+		return nil, nil
+	}
+
+	if value, ok := d.KeyColumnQuals["subscription_id"]; ok && value.GetStringValue() != subscription.Id.String() {
+		return nil, nil
+	}
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_service_hook_notification.listServiceHookNotifications", "connection_error", err)
+		return nil, err
+	}
+
+	client := servicehooks.NewClient(ctx, connection)
+
+	input := servicehooks.GetNotificationsArgs{
+		SubscriptionId: subscription.Id,
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 100 {
+			maxResults := int(*limit)
+			input.MaxResults = &maxResults
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["result"]; ok {
+		result := servicehooks.NotificationResult(value.GetStringValue())
+		if result != "" {
+			input.Result = &result
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["status"]; ok {
+		status := servicehooks.NotificationStatus(value.GetStringValue())
+		if status != "" {
+			input.Status = &status
+		}
+	}
+
+	response, err := client.GetNotifications(ctx, input)
+	if err != nil {
+		logger.Error("listServiceHookNotifications", "get_notifications_error", err)
+		return nil, err
+	}
+
+	for _, notification := range *response {
+		d.StreamListItem(ctx, notification)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func serviceHookNotificationConsumerInputs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	notification := d.HydrateItem.(servicehooks.Notification)
+	if notification.Details == nil {
+		return nil, nil
+	}
+
+	return maskSecretInputs(notification.Details.ConsumerInputs), nil
+}
@@ -0,0 +1,225 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/servicehooks"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsServiceHookSubscription(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_service_hook_subscription",
+		Description: "Represents an Azure DevOps service hook subscription.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listServiceHookSubscriptions,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "consumer_action_id", Require: plugin.Optional},
+				{Name: "consumer_id", Require: plugin.Optional},
+				{Name: "event_type", Require: plugin.Optional},
+				{Name: "publisher_id", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "action_description",
+				Description: "The description of the consumer action.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ActionDescription"),
+			},
+			{
+				Name:        "consumer_action_id",
+				Description: "The ID of the consumer action, e.g. httpRequest.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConsumerActionId"),
+			},
+			{
+				Name:        "consumer_id",
+				Description: "The ID of the consumer, e.g. webHooks or slack.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConsumerId"),
+			},
+			{
+				Name:        "consumer_inputs",
+				Description: "The consumer inputs, e.g. the target URL. Inputs which look like credentials, and the credentials in URL inputs, are masked.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(serviceHookSubscriptionConsumerInputs),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity who created the subscription.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the subscription was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedDate.Time"),
+			},
+			{
+				Name:        "event_description",
+				Description: "The description of the event the subscription is for.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventDescription"),
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of event the subscription is for, e.g. build.complete.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventType"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "modified_by",
+				Description: "The identity who last modified the subscription.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ModifiedBy"),
+			},
+			{
+				Name:        "modified_date",
+				Description: "The date the subscription was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedDate.Time"),
+			},
+			{
+				Name:        "probation_retries",
+				Description: "The number of times delivery has been retried while the subscription is on probation.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ProbationRetries"),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the subscription publishes events from, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(serviceHookSubscriptionProjectID),
+			},
+			{
+				Name:        "publisher_id",
+				Description: "The ID of the publisher, e.g. tfs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PublisherId"),
+			},
+			{
+				Name:        "publisher_inputs",
+				Description: "The publisher inputs used to filter events. Inputs which look like credentials, and the credentials in URL inputs, are masked.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(serviceHookSubscriptionPublisherInputs),
+			},
+			{
+				Name:        "resource_version",
+				Description: "The version of the event resource sent to the consumer.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceVersion"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the subscription, e.g. enabled, onProbation, disabledByUser or disabledBySystem.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status"),
+			},
+			{
+				Name:        "subscriber",
+				Description: "The identity the subscription is for.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subscriber"),
+			},
+			{
+				Name:        "url",
+				Description: "The REST API URL of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Url"),
+			},
+		},
+	}
+}
+
+func listServiceHookSubscriptions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_service_hook_subscription.listServiceHookSubscriptions", "connection_error", err)
+		return nil, err
+	}
+
+	client := servicehooks.NewClient(ctx, connection)
+
+	input := servicehooks.ListSubscriptionsArgs{}
+
+	if value, ok := d.KeyColumnQuals["consumer_action_id"]; ok {
+		consumerActionID := value.GetStringValue()
+		if consumerActionID != "" {
+			input.ConsumerActionId = &consumerActionID
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["consumer_id"]; ok {
+		consumerID := value.GetStringValue()
+		if consumerID != "" {
+			input.ConsumerId = &consumerID
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["event_type"]; ok {
+		eventType := value.GetStringValue()
+		if eventType != "" {
+			input.EventType = &eventType
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["publisher_id"]; ok {
+		publisherID := value.GetStringValue()
+		if publisherID != "" {
+			input.PublisherId = &publisherID
+		}
+	}
+
+	response, err := client.ListSubscriptions(ctx, input)
+	if err != nil {
+		logger.Error("listServiceHookSubscriptions", "list_subscriptions_error", err)
+		return nil, err
+	}
+
+	for _, subscription := range *response {
+		d.StreamListItem(ctx, subscription)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func serviceHookSubscriptionConsumerInputs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subscription := d.HydrateItem.(servicehooks.Subscription)
+	return maskSecretInputs(subscription.ConsumerInputs), nil
+}
+
+func serviceHookSubscriptionPublisherInputs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subscription := d.HydrateItem.(servicehooks.Subscription)
+	return maskSecretInputs(subscription.PublisherInputs), nil
+}
+
+func serviceHookSubscriptionProjectID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	subscription := d.HydrateItem.(servicehooks.Subscription)
+	if subscription.PublisherInputs == nil {
+		return nil, nil
+	}
+
+	if projectID, ok := (*subscription.PublisherInputs)["projectId"]; ok && projectID != "" {
+		return projectID, nil
+	}
+
+	return nil, nil
+}
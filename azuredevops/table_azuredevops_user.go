@@ -0,0 +1,254 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/memberentitlementmanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+// userLicenseIDs maps the account license types exposed in the access_level
+// column to the license IDs accepted by the $filter parameter.
+var userLicenseIDs = map[licensing.AccountLicenseType]string{
+	licensing.AccountLicenseTypeValues.Advanced:     "Account-Advanced",
+	licensing.AccountLicenseTypeValues.EarlyAdopter: "Account-EarlyAdopter",
+	licensing.AccountLicenseTypeValues.Express:      "Account-Express",
+	licensing.AccountLicenseTypeValues.Professional: "Account-Professional",
+	licensing.AccountLicenseTypeValues.Stakeholder:  "Account-Stakeholder",
+}
+
+// userEntitlementsPage is a page of user entitlements. The client's
+// PagedGraphMemberList does not include the continuation token, so the
+// response is read into this struct instead.
+type userEntitlementsPage struct {
+	Members           []memberentitlementmanagement.UserEntitlement `json:"members,omitempty"`
+	ContinuationToken *string                                       `json:"continuationToken,omitempty"`
+}
+
+func tableAzureDevOpsUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_user",
+		Description: "Represents a user in the Azure DevOps organization, along with their license entitlements.",
+
+		List: &plugin.ListConfig{
+			Hydrate: listUsers,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "access_level", Require: plugin.Optional},
+				{Name: "last_accessed_date", Operators: []string{">", ">=", "<", "<=", "="}, Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "access_level",
+				Description: "The account license type of the user, e.g. express (Basic), stakeholder or advanced (Basic + Test Plans).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessLevel.AccountLicenseType"),
+			},
+			{
+				Name:        "assignment_source",
+				Description: "The source of the license assignment, e.g. unknown or groupRule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessLevel.AssignmentSource"),
+			},
+			{
+				Name:        "date_created",
+				Description: "The date the user was added to the organization.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DateCreated.Time"),
+			},
+			{
+				Name:        "descriptor",
+				Description: "The descriptor of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.Descriptor"),
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.DisplayName"),
+			},
+			{
+				Name:        "extensions",
+				Description: "The extensions assigned to the user.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Extensions"),
+			},
+			{
+				Name:        "group_assignments",
+				Description: "The groups the user is a member of, through which entitlements may be assigned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GroupAssignments"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "last_accessed_date",
+				Description: "The date the user last accessed the organization. Null if the user has never accessed it.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("LastAccessedDate.Time").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "license_display_name",
+				Description: "The display name of the license, e.g. Basic or Stakeholder.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessLevel.LicenseDisplayName"),
+			},
+			{
+				Name:        "license_status",
+				Description: "The status of the license, e.g. active, pending or disabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessLevel.Status"),
+			},
+			{
+				Name:        "licensing_source",
+				Description: "The source of the license, e.g. account, msdn or trial.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessLevel.LicensingSource"),
+			},
+			{
+				Name:        "mail_address",
+				Description: "The email address of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.MailAddress"),
+			},
+			{
+				Name:        "msdn_license_type",
+				Description: "The Visual Studio subscription type of the user, if licensed through one.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AccessLevel.MsdnLicenseType"),
+			},
+			{
+				Name:        "origin",
+				Description: "The type of identity provider of the user, e.g. aad or msa.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.Origin"),
+			},
+			{
+				Name:        "origin_id",
+				Description: "The ID of the user in the identity provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.OriginId"),
+			},
+			{
+				Name:        "principal_name",
+				Description: "The principal name of the user, usually their email address.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.PrincipalName"),
+			},
+			{
+				Name:        "project_entitlements",
+				Description: "The projects the user has access to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProjectEntitlements"),
+			},
+			{
+				Name:        "subject_kind",
+				Description: "The kind of the graph subject.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("User.SubjectKind"),
+			},
+		},
+	}
+}
+
+func listUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_user.listUsers", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := connection.GetClientByResourceAreaId(ctx, memberentitlementmanagement.ResourceAreaId)
+	if err != nil {
+		logger.Error("azuredevops_user.listUsers", "client_error", err)
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+
+	// Group and project memberships are only returned when selected, so only ask for the ones being selected.
+	selects := []string{string(memberentitlementmanagement.UserEntitlementPropertyValues.License)}
+	if isColumnRequested(d, "extensions") {
+		selects = append(selects, string(memberentitlementmanagement.UserEntitlementPropertyValues.Extensions))
+	}
+	if isColumnRequested(d, "group_assignments") {
+		selects = append(selects, string(memberentitlementmanagement.UserEntitlementPropertyValues.GroupRules))
+	}
+	if isColumnRequested(d, "project_entitlements") {
+		selects = append(selects, string(memberentitlementmanagement.UserEntitlementPropertyValues.Projects))
+	}
+	queryParams.Set("select", strings.Join(selects, ","))
+
+	if value, ok := d.KeyColumnQuals["access_level"]; ok {
+		if licenseID, ok := userLicenseIDs[licensing.AccountLicenseType(value.GetStringValue())]; ok {
+			queryParams.Set("$filter", fmt.Sprintf("licenseId eq '%s'", licenseID))
+		}
+	}
+
+	// The $filter parameter does not support the last accessed date, so sort
+	// by it instead and stop paging once the users fall outside of the range.
+	minLastAccessed, maxLastAccessed := getQualTimeRange(d, "last_accessed_date")
+	if minLastAccessed != nil {
+		queryParams.Set("$orderBy", "lastAccessed desc")
+	} else if maxLastAccessed != nil {
+		queryParams.Set("$orderBy", "lastAccessed asc")
+	}
+
+	locationId, _ := uuid.Parse("387f832c-dbf2-4643-88e9-c1aa94dbb737")
+
+	for {
+		response, err := client.Send(ctx, http.MethodGet, locationId, "6.0-preview.3", nil, queryParams, nil, "", "application/json", nil)
+		if err != nil {
+			logger.Error("listUsers", "search_user_entitlements_error", err)
+			return nil, err
+		}
+
+		var page userEntitlementsPage
+		err = client.UnmarshalBody(response, &page)
+		if err != nil {
+			logger.Error("listUsers", "unmarshal_error", err)
+			return nil, err
+		}
+
+		for _, user := range page.Members {
+			if user.LastAccessedDate != nil {
+				if minLastAccessed != nil && user.LastAccessedDate.Time.Before(minLastAccessed.Time) {
+					return nil, nil
+				}
+				if minLastAccessed == nil && maxLastAccessed != nil && user.LastAccessedDate.Time.After(maxLastAccessed.Time) {
+					return nil, nil
+				}
+			}
+
+			d.StreamListItem(ctx, user)
+
+			if d.QueryStatus.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			break
+		}
+
+		queryParams.Set("continuationToken", *page.ContinuationToken)
+	}
+
+	return nil, nil
+}
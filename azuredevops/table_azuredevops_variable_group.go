@@ -0,0 +1,259 @@
+package azuredevops
+
+import (
+	"context"
+	"sort"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin/transform"
+)
+
+func tableAzureDevOpsVariableGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azuredevops_variable_group",
+		Description: "Represents an Azure DevOps variable group (library).",
+
+		List: &plugin.ListConfig{
+			Hydrate: listVariableGroups,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Required},
+				{Name: "name", Require: plugin.Optional},
+			},
+		},
+
+		Columns: []*plugin.Column{
+			{
+				Name:        "created_by",
+				Description: "The identity who created the variable group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CreatedBy"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The time when the variable group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedOn.Time"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Description"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the variable group.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Id"),
+			},
+			{
+				Name:        "is_shared",
+				Description: "Whether the variable group is shared with other projects.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsShared"),
+			},
+			{
+				Name:        "key_vault_last_refreshed_on",
+				Description: "The time the variables were last refreshed from Azure Key Vault.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromP(variableGroupProviderData, "lastRefreshedOn"),
+			},
+			{
+				Name:        "key_vault_name",
+				Description: "The name of the Azure Key Vault the variables are linked to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(variableGroupProviderData, "vault"),
+			},
+			{
+				Name:        "key_vault_service_endpoint_id",
+				Description: "The ID of the service connection used to access the Azure Key Vault.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(variableGroupProviderData, "serviceEndpointId"),
+			},
+			{
+				Name:        "modified_by",
+				Description: "The identity who last modified the variable group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ModifiedBy"),
+			},
+			{
+				Name:        "modified_by_display_name",
+				Description: "The display name of the identity who last modified the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ModifiedBy.DisplayName"),
+			},
+			{
+				Name:        "modified_on",
+				Description: "The time when the variable group was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ModifiedOn.Time"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the variable group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "plaintext_secret_candidates",
+				Description: "The names of non-secret variables whose value matches a known token format or is a long, high-entropy string, or whose name looks like a credential and whose value is not a short string, boolean or number.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(variableGroupPlaintextSecretCandidates),
+			},
+			{
+				Name:        "project_id",
+				Description: "ID of the project the variable group belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("project_id"),
+			},
+			{
+				Name:        "project_references",
+				Description: "The project references where the variable group is shared.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VariableGroupProjectReferences"),
+			},
+			{
+				Name:        "provider_data",
+				Description: "The provider data of the variable group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ProviderData"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the variable group, e.g. Vsts or AzureKeyVault.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Type"),
+			},
+			{
+				Name:        "variables",
+				Description: "The variables in the variable group as a map of name to value. Secret values are null.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(variableGroupVariables),
+			},
+		},
+	}
+}
+
+func listVariableGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	projectID := d.KeyColumnQuals["project_id"].GetStringValue()
+
+	connection, err := GetAzureDevOpsConnection(ctx, d)
+	if err != nil {
+		logger.Error("azuredevops_variable_group.listVariableGroups", "connection_error", err)
+		return nil, err
+	}
+
+	client, err := taskagent.NewClient(ctx, connection)
+	if err != nil {
+		logger.Error("azuredevops_variable_group.listVariableGroups", "client_error", err)
+		return nil, err
+	}
+
+	input := taskagent.GetVariableGroupsArgs{
+		Project: &projectID,
+	}
+
+	// The client does not return the continuation token, so only ask for a
+	// limited number of groups when the query itself is limited.
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit > 0 && *limit < 999 {
+			top := int(*limit)
+			input.Top = &top
+		}
+	}
+
+	if value, ok := d.KeyColumnQuals["name"]; ok {
+		name := value.GetStringValue()
+		if name != "" {
+			input.GroupName = &name
+		}
+	}
+
+	response, err := client.GetVariableGroups(ctx, input)
+	if err != nil {
+		logger.Error("listVariableGroups", "list_variable_groups_error", err)
+		return nil, err
+	}
+
+	for _, group := range *response {
+		d.StreamListItem(ctx, group)
+
+		if d.QueryStatus.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// variableGroupVariableValue is the shape of each entry in VariableGroup.Variables,
+// which the client only exposes as an untyped map.
+type variableGroupVariableValue struct {
+	IsSecret bool
+	Value    string
+}
+
+func getVariableGroupVariables(group taskagent.VariableGroup) map[string]variableGroupVariableValue {
+	variables := make(map[string]variableGroupVariableValue)
+	if group.Variables == nil {
+		return variables
+	}
+
+	for name, raw := range *group.Variables {
+		var variable variableGroupVariableValue
+		if values, ok := raw.(map[string]interface{}); ok {
+			variable.IsSecret, _ = values["isSecret"].(bool)
+			variable.Value, _ = values["value"].(string)
+		}
+		variables[name] = variable
+	}
+
+	return variables
+}
+
+func variableGroupVariables(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group := d.HydrateItem.(taskagent.VariableGroup)
+	if group.Variables == nil {
+		return nil, nil
+	}
+
+	variables := make(map[string]interface{})
+	for name, variable := range getVariableGroupVariables(group) {
+		if variable.IsSecret {
+			variables[name] = nil
+		} else {
+			variables[name] = variable.Value
+		}
+	}
+
+	return variables, nil
+}
+
+func variableGroupPlaintextSecretCandidates(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group := d.HydrateItem.(taskagent.VariableGroup)
+
+	candidates := []string{}
+	for name, variable := range getVariableGroupVariables(group) {
+		if !variable.IsSecret && isPlaintextSecretCandidate(name, variable.Value) {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+
+	return candidates, nil
+}
+
+func variableGroupProviderData(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	group := d.HydrateItem.(taskagent.VariableGroup)
+
+	providerData, ok := group.ProviderData.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	return providerData[d.Param.(string)], nil
+}
@@ -0,0 +1,95 @@
+package azuredevops
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	ado "github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/turbot/steampipe-plugin-sdk/v4/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v4/plugin"
+)
+
+// getQualIntList returns the integer values of an equality qual, which may be
+// either a single value or a list of values (e.g. from an IN clause).
+func getQualIntList(value *proto.QualValue) []int {
+	if list := value.GetListValue(); list != nil {
+		values := make([]int, 0, len(list.Values))
+		for _, item := range list.Values {
+			values = append(values, int(item.GetInt64Value()))
+		}
+		return values
+	}
+
+	return []int{int(value.GetInt64Value())}
+}
+
+// getQualTimeRange converts the comparison quals on a timestamp column into
+// the inclusive lower and upper bounds accepted by the Azure DevOps APIs.
+func getQualTimeRange(d *plugin.QueryData, column string) (*ado.Time, *ado.Time) {
+	var min, max *ado.Time
+
+	if d.Quals[column] == nil {
+		return min, max
+	}
+
+	for _, q := range d.Quals[column].Quals {
+		value := &ado.Time{Time: q.Value.GetTimestampValue().AsTime()}
+
+		switch q.Operator {
+		case ">", ">=":
+			min = value
+		case "<", "<=":
+			max = value
+		case "=":
+			min = value
+			max = value
+		}
+	}
+
+	return min, max
+}
+
+// isColumnRequested returns true if the query selects the given column.
+func isColumnRequested(d *plugin.QueryData, column string) bool {
+	for _, c := range d.QueryContext.Columns {
+		if c == column {
+			return true
+		}
+	}
+
+	return false
+}
+
+// secondsBetween returns the number of seconds between start and end. If end
+// is nil the interval is still open, so the current time is used instead. If
+// start is nil there is no interval and nil is returned.
+func secondsBetween(start *ado.Time, end *ado.Time) interface{} {
+	if start == nil {
+		return nil
+	}
+
+	endTime := time.Now()
+	if end != nil {
+		endTime = end.Time
+	}
+
+	return endTime.Sub(start.Time).Seconds()
+}
+
+// isNotFoundOrForbiddenError returns true if the error is an Azure DevOps API
+// error for a resource which does not exist or which the caller is not
+// permitted to read.
+func isNotFoundOrForbiddenError(err error) bool {
+	var statusCode *int
+
+	var wrappedError ado.WrappedError
+	var wrappedErrorPtr *ado.WrappedError
+	if errors.As(err, &wrappedError) {
+		statusCode = wrappedError.StatusCode
+	} else if errors.As(err, &wrappedErrorPtr) && wrappedErrorPtr != nil {
+		statusCode = wrappedErrorPtr.StatusCode
+	}
+
+	return statusCode != nil && (*statusCode == http.StatusNotFound || *statusCode == http.StatusForbidden)
+}
@@ -3,6 +3,7 @@ module github.com/jleight/steampipe-plugin-azuredevops
 go 1.19
 
 require (
+	github.com/google/uuid v1.1.2
 	github.com/microsoft/azure-devops-go-api/azuredevops/v6 v6.0.1
 	github.com/turbot/steampipe-plugin-sdk/v4 v4.1.8
 )
@@ -30,7 +31,6 @@ require (
 	github.com/go-redis/redis/v8 v8.11.5 // indirect
 	github.com/golang/glog v1.0.0 // indirect
 	github.com/golang/protobuf v1.5.2 // indirect
-	github.com/google/uuid v1.1.2 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.0 // indirect
 	github.com/hashicorp/go-hclog v1.2.2 // indirect
 	github.com/hashicorp/go-plugin v1.4.4 // indirect